@@ -26,6 +26,8 @@ const (
 var (
 	listOps   = flag.String("ListOps", "", "ListOps")
 	paginator = flag.String("Paginator", "NextToken", "name of the pagination token field")
+	tokenIn   = flag.String("TokenField", "", "name of the input pagination token field, defaults to Paginator")
+	tokenOut  = flag.String("TokenOutputField", "", "name of the output pagination token field, defaults to TokenField")
 	export    = flag.Bool("Export", false, "whether to export the list functions")
 )
 
@@ -40,8 +42,9 @@ type TemplateData struct {
 	AWSService     string
 	ServicePackage string
 
-	ListOps   string
-	Paginator string
+	ListOps          string
+	TokenField       string
+	TokenOutputField string
 }
 
 func main() {
@@ -68,18 +71,28 @@ func main() {
 	}
 
 	templateData := TemplateData{
-		AWSService:     awsService,
-		ServicePackage: servicePackage,
-		ListOps:        *listOps,
-		Paginator:      *paginator,
+		AWSService:       awsService,
+		ServicePackage:   servicePackage,
+		ListOps:          *listOps,
+		TokenField:       *paginator,
+		TokenOutputField: *tokenOut,
+	}
+
+	if *tokenIn != "" {
+		templateData.TokenField = *tokenIn
+	}
+
+	if templateData.TokenOutputField == "" {
+		templateData.TokenOutputField = templateData.TokenField
 	}
 
 	functions := strings.Split(templateData.ListOps, ",")
 	sort.Strings(functions)
 
 	g := Generator{
-		paginator: templateData.Paginator,
-		tmpl:      template.Must(template.New("function").Parse(functionTemplate)),
+		tokenField:       templateData.TokenField,
+		tokenOutputField: templateData.TokenOutputField,
+		tmpl:             template.Must(template.New("function").Parse(functionTemplate)),
 	}
 
 	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", templateData.AWSService)
@@ -110,10 +123,11 @@ type HeaderInfo struct {
 }
 
 type Generator struct {
-	buf       bytes.Buffer
-	pkg       *Package
-	tmpl      *template.Template
-	paginator string
+	buf              bytes.Buffer
+	pkg              *Package
+	tmpl             *template.Template
+	tokenField       string
+	tokenOutputField string
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
@@ -165,12 +179,13 @@ func (g *Generator) addPackage(pkg *packages.Package) {
 }
 
 type FuncSpec struct {
-	Name       string
-	AWSName    string
-	RecvType   string
-	ParamType  string
-	ResultType string
-	Paginator  string
+	Name             string
+	AWSName          string
+	RecvType         string
+	ParamType        string
+	ResultType       string
+	TokenField       string
+	TokenOutputField string
 }
 
 func (g *Generator) generateFunction(functionName string, export bool) {
@@ -204,20 +219,24 @@ func (g *Generator) generateFunction(functionName string, export bool) {
 	}
 
 	funcSpec := FuncSpec{
-		Name:       fixSomeInitialisms(funcName),
-		AWSName:    function.Name.Name,
-		RecvType:   g.expandTypeField(function.Recv),
-		ParamType:  g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
-		ResultType: g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
-		Paginator:  g.paginator,
+		Name:             fixSomeInitialisms(funcName),
+		AWSName:          function.Name.Name,
+		RecvType:         g.expandTypeField(function.Recv),
+		ParamType:        g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
+		ResultType:       g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
+		TokenField:       g.tokenField,
+		TokenOutputField: g.tokenOutputField,
 	}
 
-	err := g.tmpl.Execute(&g.buf, funcSpec)
-	if err != nil {
+	if err := g.printFunction(funcSpec); err != nil {
 		log.Fatalf("error writing function \"%s\": %s", functionName, err)
 	}
 }
 
+func (g *Generator) printFunction(funcSpec FuncSpec) error {
+	return g.tmpl.Execute(&g.buf, funcSpec)
+}
+
 func (g *Generator) expandTypeField(field *ast.FieldList) string {
 	typeValue := field.List[0].Type
 	if star, ok := typeValue.(*ast.StarExpr); ok {
@@ -262,12 +281,12 @@ func {{ .Name }}PagesWithContext(ctx context.Context, conn {{ .RecvType }}, inpu
 			return err
 		}
 
-		lastPage := aws.StringValue(output.{{ .Paginator }}) == ""
+		lastPage := aws.StringValue(output.{{ .TokenOutputField }}) == ""
 		if !fn(output, lastPage) || lastPage {
 			break
 		}
 
-		input.{{ .Paginator }} = output.{{ .Paginator }}
+		input.{{ .TokenField }} = output.{{ .TokenOutputField }}
 	}
 	return nil
 }
@@ -0,0 +1,89 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"flag"
+	"html/template"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestPrintFunction(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		FuncSpec FuncSpec
+	}{
+		{
+			Name: "next_token",
+			FuncSpec: FuncSpec{
+				Name:             "getAPIs",
+				AWSName:          "GetApis",
+				RecvType:         "*apigatewayv2.ApiGatewayV2",
+				ParamType:        "*apigatewayv2.GetApisInput",
+				ResultType:       "*apigatewayv2.GetApisOutput",
+				TokenField:       "NextToken",
+				TokenOutputField: "NextToken",
+			},
+		},
+		{
+			Name: "position",
+			FuncSpec: FuncSpec{
+				Name:             "getRestAPIs",
+				AWSName:          "GetRestApis",
+				RecvType:         "*apigateway.APIGateway",
+				ParamType:        "*apigateway.GetRestApisInput",
+				ResultType:       "*apigateway.GetRestApisOutput",
+				TokenField:       "Position",
+				TokenOutputField: "Position",
+			},
+		},
+		{
+			Name: "marker",
+			FuncSpec: FuncSpec{
+				Name:             "listThings",
+				AWSName:          "ListThings",
+				RecvType:         "*example.Example",
+				ParamType:        "*example.ListThingsInput",
+				ResultType:       "*example.ListThingsOutput",
+				TokenField:       "Marker",
+				TokenOutputField: "NextMarker",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			g := Generator{
+				tmpl: template.Must(template.New("function").Parse(functionTemplate)),
+			}
+
+			if err := g.printFunction(testCase.FuncSpec); err != nil {
+				t.Fatalf("error writing function: %s", err)
+			}
+
+			got := g.format()
+			golden := filepath.Join("testdata", testCase.Name+".golden")
+
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("error writing golden file: %s", err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+
+			if err != nil {
+				t.Fatalf("error reading golden file: %s", err)
+			}
+
+			if string(got) != string(want) {
+				t.Errorf("generated output does not match %s\n\ngot:\n%s\n\nwant:\n%s", golden, got, want)
+			}
+		})
+	}
+}
@@ -25,6 +25,8 @@ var (
 	untagInNeedTagType = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
 	updateTags         = flag.Bool("UpdateTags", false, "whether to generate UpdateTags")
 
+	updateTagsIgnoreConfig = flag.Bool("UpdateTagsIgnoreConfig", false, "whether UpdateTags accepts an ignore tags configuration")
+
 	listTagsInFiltIDName  = flag.String("ListTagsInFiltIDName", "", "listTagsInFiltIDName")
 	listTagsInIDElem      = flag.String("ListTagsInIDElem", "ResourceArn", "listTagsInIDElem")
 	listTagsInIDNeedSlice = flag.String("ListTagsInIDNeedSlice", "", "listTagsInIDNeedSlice")
@@ -94,6 +96,7 @@ type TemplateData struct {
 	UntagInNeedTagType      bool
 	UntagInTagsElem         string
 	UntagOp                 string
+	UpdateTagsIgnoreConfig  bool
 
 	// The following are specific to writing import paths in the `headerBody`;
 	// to include the package, set the corresponding field's value to true
@@ -167,6 +170,7 @@ func main() {
 		UntagInNeedTagType:      *untagInNeedTagType,
 		UntagInTagsElem:         *untagInTagsElem,
 		UntagOp:                 *untagOp,
+		UpdateTagsIgnoreConfig:  *updateTagsIgnoreConfig,
 	}
 
 	if *getTag || *listTags || *serviceTagsMap || *serviceTagsSlice || *updateTags {
@@ -200,36 +204,45 @@ func main() {
 }
 
 func writeTemplate(body string, templateName string, td TemplateData) {
+	contents, err := executeTemplate(body, templateName, td)
+	if err != nil {
+		log.Fatalf("%s", err)
+	}
+
 	// If the file doesn't exist, create it, or append to the file
 	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
 		log.Fatalf("error opening file (%s): %s", filename, err)
 	}
 
+	if _, err := f.Write(contents); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		log.Fatalf("error closing file (%s): %s", filename, err)
+	}
+}
+
+func executeTemplate(body string, templateName string, td TemplateData) ([]byte, error) {
 	tplate, err := template.New(templateName).Parse(body)
 	if err != nil {
-		log.Fatalf("error parsing template: %s", err)
+		return nil, fmt.Errorf("error parsing template: %w", err)
 	}
 
 	var buffer bytes.Buffer
 	err = tplate.Execute(&buffer, td)
 	if err != nil {
-		log.Fatalf("error executing template: %s", err)
+		return nil, fmt.Errorf("error executing template: %w", err)
 	}
 
 	contents, err := format.Source(buffer.Bytes())
 	if err != nil {
-		log.Fatalf("error formatting generated file: %s", err)
-	}
-
-	if _, err := f.Write(contents); err != nil {
-		f.Close() // ignore error; Write error takes precedence
-		log.Fatalf("error writing to file (%s): %s", filename, err)
+		return nil, fmt.Errorf("error formatting generated file: %w", err)
 	}
 
-	if err := f.Close(); err != nil {
-		log.Fatalf("error closing file (%s): %s", filename, err)
-	}
+	return contents, nil
 }
 
 var headerBody = `
@@ -650,13 +663,19 @@ var updatetagsBody = `
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 {{- if  .TagTypeAddBoolElem }}
-func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsSet interface{}, newTagsSet interface{}) error {
+func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsSet interface{}, newTagsSet interface{}{{ if .UpdateTagsIgnoreConfig }}, ignoreConfig *tftags.IgnoreConfig{{ end }}) error {
 	oldTags := KeyValueTags(oldTagsSet, identifier{{ if .TagResTypeElem }}, resourceType{{ end }})
 	newTags := KeyValueTags(newTagsSet, identifier{{ if .TagResTypeElem }}, resourceType{{ end }})
 {{- else }}
-func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsMap interface{}, newTagsMap interface{}) error {
+func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}, oldTagsMap interface{}, newTagsMap interface{}{{ if .UpdateTagsIgnoreConfig }}, ignoreConfig *tftags.IgnoreConfig{{ end }}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
+{{- end }}
+{{- if .UpdateTagsIgnoreConfig }}
+
+	// Ignored tags are never removed from or added to the resource
+	oldTags = oldTags.IgnoreConfig(ignoreConfig)
+	newTags = newTags.IgnoreConfig(ignoreConfig)
 {{- end }}
 	{{- if eq (.TagOp) (.UntagOp) }}
 	removedTags := oldTags.Removed(newTags)
@@ -0,0 +1,103 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestExecuteTemplate(t *testing.T) {
+	templateData := TemplateData{
+		AWSService:          "apigatewayv2",
+		ClientType:          "*apigatewayv2.ApiGatewayV2",
+		ServicePackage:      "apigatewayv2",
+		ListTagsInIDElem:    "ResourceArn",
+		ListTagsOp:          "GetTags",
+		ListTagsOutTagsElem: "Tags",
+		TagInIDElem:         "ResourceArn",
+		TagInTagsElem:       "Tags",
+		TagOp:               "TagResource",
+		TagPackage:          "apigatewayv2",
+		UntagInTagsElem:     "TagKeys",
+		UntagOp:             "UntagResource",
+	}
+
+	testCases := []struct {
+		Name         string
+		Body         string
+		TemplateData func(TemplateData) TemplateData
+	}{
+		{
+			Name: "list_tags",
+			Body: listtagsBody,
+		},
+		{
+			Name: "list_tags_parent_not_found",
+			Body: listtagsBody,
+			TemplateData: func(td TemplateData) TemplateData {
+				td.ParentNotFoundErrCode = "NotFoundException"
+				return td
+			},
+		},
+		{
+			Name: "list_tags_parent_not_found_message",
+			Body: listtagsBody,
+			TemplateData: func(td TemplateData) TemplateData {
+				td.ParentNotFoundErrCode = "ResourceNotFoundException"
+				td.ParentNotFoundErrMsg = "Parent resource not found"
+				return td
+			},
+		},
+		{
+			Name: "update_tags",
+			Body: updatetagsBody,
+		},
+		{
+			Name: "update_tags_ignore_config",
+			Body: updatetagsBody,
+			TemplateData: func(td TemplateData) TemplateData {
+				td.UpdateTagsIgnoreConfig = true
+				return td
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			td := templateData
+			if testCase.TemplateData != nil {
+				td = testCase.TemplateData(td)
+			}
+
+			got, err := executeTemplate(testCase.Body, testCase.Name, td)
+
+			if err != nil {
+				t.Fatalf("error executing template: %s", err)
+			}
+
+			golden := filepath.Join("testdata", testCase.Name+".golden")
+
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("error writing golden file: %s", err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+
+			if err != nil {
+				t.Fatalf("error reading golden file: %s", err)
+			}
+
+			if string(got) != string(want) {
+				t.Errorf("generated output does not match %s\n\ngot:\n%s\n\nwant:\n%s", golden, got, want)
+			}
+		})
+	}
+}
@@ -423,9 +423,22 @@ func Provider() *schema.Provider {
 			"aws_api_gateway_sdk":         apigateway.DataSourceSdk(),
 			"aws_api_gateway_vpc_link":    apigateway.DataSourceVPCLink(),
 
-			"aws_apigatewayv2_api":    apigatewayv2.DataSourceAPI(),
-			"aws_apigatewayv2_apis":   apigatewayv2.DataSourceAPIs(),
-			"aws_apigatewayv2_export": apigatewayv2.DataSourceExport(),
+			"aws_apigatewayv2_api":                   apigatewayv2.DataSourceAPI(),
+			"aws_apigatewayv2_api_mapping":           apigatewayv2.DataSourceAPIMapping(),
+			"aws_apigatewayv2_api_mappings":          apigatewayv2.DataSourceAPIMappings(),
+			"aws_apigatewayv2_apis":                  apigatewayv2.DataSourceAPIs(),
+			"aws_apigatewayv2_authorizers":           apigatewayv2.DataSourceAuthorizers(),
+			"aws_apigatewayv2_deployment":            apigatewayv2.DataSourceDeployment(),
+			"aws_apigatewayv2_domain_names":          apigatewayv2.DataSourceDomainNames(),
+			"aws_apigatewayv2_export":                apigatewayv2.DataSourceExport(),
+			"aws_apigatewayv2_integration_responses": apigatewayv2.DataSourceIntegrationResponses(),
+			"aws_apigatewayv2_integrations":          apigatewayv2.DataSourceIntegrations(),
+			"aws_apigatewayv2_models":                apigatewayv2.DataSourceModels(),
+			"aws_apigatewayv2_route_responses":       apigatewayv2.DataSourceRouteResponses(),
+			"aws_apigatewayv2_routes":                apigatewayv2.DataSourceRoutes(),
+			"aws_apigatewayv2_stage":                 apigatewayv2.DataSourceStage(),
+			"aws_apigatewayv2_vpc_link":              apigatewayv2.DataSourceVPCLink(),
+			"aws_apigatewayv2_vpc_links":             apigatewayv2.DataSourceVPCLinks(),
 
 			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
 			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
@@ -791,12 +804,13 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
 			"aws_route53_resolver_rules":    route53resolver.DataSourceRules(),
 
-			"aws_canonical_user_id": s3.DataSourceCanonicalUserID(),
-			"aws_s3_bucket":         s3.DataSourceBucket(),
-			"aws_s3_object":         s3.DataSourceObject(),
-			"aws_s3_objects":        s3.DataSourceObjects(),
-			"aws_s3_bucket_object":  s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
-			"aws_s3_bucket_objects": s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
+			"aws_canonical_user_id":         s3.DataSourceCanonicalUserID(),
+			"aws_s3_bucket":                 s3.DataSourceBucket(),
+			"aws_s3_bucket_object_versions": s3.DataSourceBucketObjectVersions(),
+			"aws_s3_object":                 s3.DataSourceObject(),
+			"aws_s3_objects":                s3.DataSourceObjects(),
+			"aws_s3_bucket_object":          s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
+			"aws_s3_bucket_objects":         s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
 
 			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
 
@@ -3,7 +3,9 @@ package apigatewayv2
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -20,8 +22,10 @@ func DataSourceAPI() *schema.Resource {
 				Computed: true,
 			},
 			"api_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"api_id", "name"},
 			},
 			"api_key_selection_expression": {
 				Type:     schema.TypeString,
@@ -84,8 +88,10 @@ func DataSourceAPI() *schema.Resource {
 				Computed: true,
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"api_id", "name"},
 			},
 			"protocol_type": {
 				Type:     schema.TypeString,
@@ -109,6 +115,33 @@ func dataSourceAPIRead(d *schema.ResourceData, meta interface{}) error {
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 	apiID := d.Get("api_id").(string)
 
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		apis, err := FindAPIs(conn, &apigatewayv2.GetApisInput{})
+
+		if err != nil {
+			return fmt.Errorf("error reading API Gateway v2 APIs: %w", err)
+		}
+
+		var ids []string
+
+		for _, api := range apis {
+			if aws.StringValue(api.Name) == name {
+				ids = append(ids, aws.StringValue(api.ApiId))
+			}
+		}
+
+		if len(ids) == 0 {
+			return fmt.Errorf("no API Gateway v2 API matched; change the search criteria and try again")
+		}
+
+		if len(ids) > 1 {
+			return fmt.Errorf("%d API Gateway v2 APIs matched; use additional constraints to reduce matches to a single API", len(ids))
+		}
+
+		apiID = ids[0]
+	}
+
 	api, err := FindAPIByID(conn, apiID)
 
 	if tfresource.NotFound(err) {
@@ -122,6 +155,7 @@ func dataSourceAPIRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(apiID)
 
 	d.Set("api_endpoint", api.ApiEndpoint)
+	d.Set("api_id", apiID)
 	d.Set("api_key_selection_expression", api.ApiKeySelectionExpression)
 	apiArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
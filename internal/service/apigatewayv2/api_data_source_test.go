@@ -84,6 +84,33 @@ func TestAccAPIGatewayV2APIDataSource_webSocket(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2APIDataSource_name(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_api.test"
+	resourceName := "aws_apigatewayv2_api.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPINameDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_endpoint", resourceName, "api_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "execution_arn", resourceName, "execution_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "protocol_type", resourceName, "protocol_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAPIHTTPDataSourceConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_apigatewayv2_api" "test" {
@@ -131,3 +158,20 @@ data "aws_apigatewayv2_api" "test" {
 }
 `, rName)
 }
+
+func testAccAPINameDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+
+  tags = {
+    Key1 = "Value1"
+  }
+}
+
+data "aws_apigatewayv2_api" "test" {
+  name = aws_apigatewayv2_api.test.name
+}
+`, rName)
+}
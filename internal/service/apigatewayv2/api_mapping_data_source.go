@@ -0,0 +1,82 @@
+package apigatewayv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceAPIMapping() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAPIMappingRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_mapping_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_mapping_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAPIMappingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	domainName := d.Get("domain_name").(string)
+	apiMappingKey := d.Get("api_mapping_key").(string)
+
+	apiMappings, err := FindAPIMappings(conn, &apigatewayv2.GetApiMappingsInput{
+		DomainName: aws.String(domainName),
+	})
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("no API Gateway v2 domain name (%s) matched; change the search criteria and try again", domainName)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 domain name (%s) API mappings: %w", domainName, err)
+	}
+
+	var apiMapping *apigatewayv2.ApiMapping
+
+	// The root mapping of a domain name has an empty (or absent) mapping key.
+	for _, v := range apiMappings {
+		if aws.StringValue(v.ApiMappingKey) == apiMappingKey {
+			apiMapping = v
+			break
+		}
+	}
+
+	if apiMapping == nil {
+		return fmt.Errorf("no API Gateway v2 API mapping matched; change the search criteria and try again")
+	}
+
+	d.SetId(aws.StringValue(apiMapping.ApiMappingId))
+	d.Set("api_id", apiMapping.ApiId)
+	d.Set("api_mapping_id", apiMapping.ApiMappingId)
+	d.Set("api_mapping_key", apiMapping.ApiMappingKey)
+	d.Set("domain_name", domainName)
+	d.Set("stage", apiMapping.Stage)
+
+	return nil
+}
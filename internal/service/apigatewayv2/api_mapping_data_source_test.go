@@ -0,0 +1,63 @@
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// testAccAPIMappingDataSource_basic is run from TestAccAPIGatewayV2APIMapping_basic
+// so that it can share the ACM certificate.
+func testAccAPIMappingDataSource_basic(t *testing.T, rName string, certificateArn *string) {
+	dataSourceName := "data.aws_apigatewayv2_api_mapping.test"
+	resourceName := "aws_apigatewayv2_api_mapping.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAPIMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIMappingDataSourceConfig_basic(rName, *certificateArn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_id", resourceName, "api_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_mapping_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "api_mapping_key", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage", resourceName, "stage"),
+				),
+			},
+			{
+				Config: testAccAPIMappingDataSourceConfig_apiMappingKey(rName, *certificateArn, "$context.domainName"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_id", resourceName, "api_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_mapping_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_mapping_key", resourceName, "api_mapping_key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage", resourceName, "stage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIMappingDataSourceConfig_basic(rName, certificateArn string) string {
+	return acctest.ConfigCompose(testAccAPIMappingConfig_basic(rName, certificateArn), `
+data "aws_apigatewayv2_api_mapping" "test" {
+  domain_name = aws_apigatewayv2_api_mapping.test.domain_name
+}
+`)
+}
+
+func testAccAPIMappingDataSourceConfig_apiMappingKey(rName, certificateArn, apiMappingKey string) string {
+	return acctest.ConfigCompose(testAccAPIMappingConfig_apiMappingKey(rName, certificateArn, apiMappingKey), fmt.Sprintf(`
+data "aws_apigatewayv2_api_mapping" "test" {
+  domain_name     = aws_apigatewayv2_api_mapping.test.domain_name
+  api_mapping_key = %[1]q
+}
+`, apiMappingKey))
+}
@@ -32,9 +32,11 @@ func TestAccAPIGatewayV2APIMapping_basic(t *testing.T) {
 	})
 
 	testCases := map[string]func(t *testing.T, rName string, certificateArn *string){
-		"basic":         testAccAPIMapping_basic,
-		"disappears":    testAccAPIMapping_disappears,
-		"ApiMappingKey": testAccAPIMapping_ApiMappingKey,
+		"basic":          testAccAPIMapping_basic,
+		"disappears":     testAccAPIMapping_disappears,
+		"ApiMappingKey":  testAccAPIMapping_ApiMappingKey,
+		"dataSource":     testAccAPIMappingDataSource_basic,
+		"dataSourceList": testAccAPIMappingsDataSource_basic,
 	}
 	for name, tc := range testCases {
 		tc := tc
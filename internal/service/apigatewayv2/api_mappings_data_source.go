@@ -0,0 +1,98 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceAPIMappings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAPIMappingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_mapping_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_mapping_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceAPIMappingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	domainName := d.Get("domain_name").(string)
+
+	apiMappings, err := FindAPIMappings(conn, &apigatewayv2.GetApiMappingsInput{
+		DomainName: aws.String(domainName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 domain name (%s) API mappings: %w", domainName, err)
+	}
+
+	sort.Slice(apiMappings, func(i, j int) bool {
+		return aws.StringValue(apiMappings[i].ApiMappingId) < aws.StringValue(apiMappings[j].ApiMappingId)
+	})
+
+	var ids []*string
+	var tfList []interface{}
+
+	for _, apiMapping := range apiMappings {
+		ids = append(ids, apiMapping.ApiMappingId)
+		tfList = append(tfList, map[string]interface{}{
+			"api_id":          aws.StringValue(apiMapping.ApiId),
+			"api_mapping_id":  aws.StringValue(apiMapping.ApiMappingId),
+			"api_mapping_key": aws.StringValue(apiMapping.ApiMappingKey),
+			"stage":           aws.StringValue(apiMapping.Stage),
+		})
+	}
+
+	d.SetId(domainName)
+
+	if err := d.Set("api_mappings", tfList); err != nil {
+		return fmt.Errorf("error setting api_mappings: %w", err)
+	}
+
+	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	return nil
+}
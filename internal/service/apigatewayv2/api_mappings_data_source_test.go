@@ -0,0 +1,44 @@
+package apigatewayv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// testAccAPIMappingsDataSource_basic is run from TestAccAPIGatewayV2APIMapping_basic
+// so that it can share the ACM certificate.
+func testAccAPIMappingsDataSource_basic(t *testing.T, rName string, certificateArn *string) {
+	dataSourceName := "data.aws_apigatewayv2_api_mappings.test"
+	resourceName := "aws_apigatewayv2_api_mapping.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAPIMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIMappingsDataSourceConfig_basic(rName, *certificateArn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "api_mappings.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_mappings.0.api_id", resourceName, "api_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_mappings.0.api_mapping_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "api_mappings.0.api_mapping_key", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_mappings.0.stage", resourceName, "stage"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIMappingsDataSourceConfig_basic(rName, certificateArn string) string {
+	return acctest.ConfigCompose(testAccAPIMappingConfig_basic(rName, certificateArn), `
+data "aws_apigatewayv2_api_mappings" "test" {
+  domain_name = aws_apigatewayv2_api_mapping.test.domain_name
+}
+`)
+}
@@ -2,6 +2,7 @@ package apigatewayv2
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -23,8 +24,14 @@ func DataSourceAPIs() *schema.Resource {
 				Set:      schema.HashString,
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
 			},
 			"protocol_type": {
 				Type:     schema.TypeString,
@@ -54,6 +61,10 @@ func dataSourceAPIsRead(d *schema.ResourceData, meta interface{}) error {
 			continue
 		}
 
+		if v, ok := d.GetOk("name_prefix"); ok && !strings.HasPrefix(aws.StringValue(api.Name), v.(string)) {
+			continue
+		}
+
 		if v, ok := d.GetOk("protocol_type"); ok && v.(string) != aws.StringValue(api.ProtocolType) {
 			continue
 		}
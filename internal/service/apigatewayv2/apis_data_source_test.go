@@ -33,6 +33,30 @@ func TestAccAPIGatewayV2APIsDataSource_name(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2APIsDataSource_namePrefix(t *testing.T) {
+	dataSource1Name := "data.aws_apigatewayv2_apis.test1"
+	dataSource2Name := "data.aws_apigatewayv2_apis.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName1 := fmt.Sprintf("%s-1", rName)
+	rName2 := fmt.Sprintf("%s-2", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIsNamePrefixDataSourceConfig(rName, rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource1Name, "ids.#", "3"),
+					resource.TestCheckResourceAttr(dataSource2Name, "ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayV2APIsDataSource_protocolType(t *testing.T) {
 	dataSource1Name := "data.aws_apigatewayv2_apis.test1"
 	dataSource2Name := "data.aws_apigatewayv2_apis.test2"
@@ -129,6 +153,23 @@ data "aws_apigatewayv2_apis" "test2" {
 `)
 }
 
+func testAccAPIsNamePrefixDataSourceConfig(rName, rName1, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccAPIsBaseDataSourceConfig(rName1, rName2),
+		fmt.Sprintf(`
+data "aws_apigatewayv2_apis" "test1" {
+  name_prefix = %[1]q
+
+  depends_on = [aws_apigatewayv2_api.test1, aws_apigatewayv2_api.test2, aws_apigatewayv2_api.test3]
+}
+
+data "aws_apigatewayv2_apis" "test2" {
+  # Force dependency on resources.
+  name_prefix = element([aws_apigatewayv2_api.test1.name, aws_apigatewayv2_api.test2.name, aws_apigatewayv2_api.test3.name], 1)
+}
+`, rName))
+}
+
 func testAccAPIsProtocolTypeDataSourceConfig(rName1, rName2 string) string {
 	return acctest.ConfigCompose(
 		testAccAPIsBaseDataSourceConfig(rName1, rName2),
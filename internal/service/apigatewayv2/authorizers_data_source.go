@@ -0,0 +1,110 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceAuthorizers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAuthorizersRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authorizer_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(apigatewayv2.AuthorizerType_Values(), false),
+			},
+			"authorizers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorizer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorizer_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identity_sources": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceAuthorizersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+
+	authorizers, err := FindAuthorizers(conn, &apigatewayv2.GetAuthorizersInput{
+		ApiId: aws.String(apiID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 API (%s) authorizers: %w", apiID, err)
+	}
+
+	sort.Slice(authorizers, func(i, j int) bool {
+		return aws.StringValue(authorizers[i].AuthorizerId) < aws.StringValue(authorizers[j].AuthorizerId)
+	})
+
+	var ids []*string
+	var tfList []interface{}
+
+	for _, authorizer := range authorizers {
+		if v, ok := d.GetOk("authorizer_type"); ok && v.(string) != aws.StringValue(authorizer.AuthorizerType) {
+			continue
+		}
+
+		ids = append(ids, authorizer.AuthorizerId)
+		tfList = append(tfList, map[string]interface{}{
+			"authorizer_id":    aws.StringValue(authorizer.AuthorizerId),
+			"authorizer_type":  aws.StringValue(authorizer.AuthorizerType),
+			"identity_sources": flex.FlattenStringSet(authorizer.IdentitySource),
+			"name":             aws.StringValue(authorizer.Name),
+		})
+	}
+
+	d.SetId(apiID)
+
+	if err := d.Set("authorizers", tfList); err != nil {
+		return fmt.Errorf("error setting authorizers: %w", err)
+	}
+
+	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	return nil
+}
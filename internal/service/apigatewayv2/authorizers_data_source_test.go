@@ -0,0 +1,65 @@
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2AuthorizersDataSource_basic(t *testing.T) {
+	dataSource1Name := "data.aws_apigatewayv2_authorizers.test1"
+	dataSource2Name := "data.aws_apigatewayv2_authorizers.test2"
+	resourceName := "aws_apigatewayv2_authorizer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizersDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource1Name, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSource1Name, "authorizers.#", "2"),
+					resource.TestCheckResourceAttr(dataSource2Name, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSource2Name, "ids.*", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "authorizers.0.authorizer_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "authorizers.0.authorizer_type", resourceName, "authorizer_type"),
+					resource.TestCheckResourceAttr(dataSource2Name, "authorizers.0.identity_sources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSource2Name, "authorizers.0.identity_sources.*", "$request.header.Authorization"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "authorizers.0.name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuthorizersDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAuthorizerConfig_jwt(rName), fmt.Sprintf(`
+resource "aws_apigatewayv2_authorizer" "test2" {
+  api_id                            = aws_apigatewayv2_api.test.id
+  authorizer_payload_format_version = "2.0"
+  authorizer_type                   = "REQUEST"
+  authorizer_uri                    = aws_lambda_function.test.invoke_arn
+  identity_sources                  = ["$request.header.Auth"]
+  name                              = "%[1]s-2"
+}
+
+data "aws_apigatewayv2_authorizers" "test1" {
+  # Force dependency on resources.
+  api_id = element([aws_apigatewayv2_authorizer.test.api_id, aws_apigatewayv2_authorizer.test2.api_id], 0)
+}
+
+data "aws_apigatewayv2_authorizers" "test2" {
+  # Force dependency on resources.
+  api_id          = element([aws_apigatewayv2_authorizer.test.api_id, aws_apigatewayv2_authorizer.test2.api_id], 0)
+  authorizer_type = aws_apigatewayv2_authorizer.test.authorizer_type
+}
+`, rName))
+}
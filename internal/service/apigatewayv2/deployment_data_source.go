@@ -0,0 +1,79 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDeploymentRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"auto_deployed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+	deploymentID := d.Get("deployment_id").(string)
+
+	deployment, err := FindDeploymentByID(conn, apiID, deploymentID)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("no API Gateway v2 deployment matched; change the search criteria and try again")
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 deployment (%s): %w", deploymentID, err)
+	}
+
+	d.SetId(deploymentID)
+
+	d.Set("auto_deployed", deployment.AutoDeployed)
+	if v := deployment.CreatedDate; v != nil {
+		d.Set("created_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("created_date", nil)
+	}
+	d.Set("deployment_status", deployment.DeploymentStatus)
+	d.Set("deployment_status_message", deployment.DeploymentStatusMessage)
+	d.Set("description", deployment.Description)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package apigatewayv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2DeploymentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_deployment.test"
+	resourceName := "aws_apigatewayv2_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_deployed", resourceName, "auto_deployed"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployment_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployment_status", apigatewayv2.DeploymentStatusDeployed),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_basic(rName, "Test description"), `
+data "aws_apigatewayv2_deployment" "test" {
+  api_id        = aws_apigatewayv2_deployment.test.api_id
+  deployment_id = aws_apigatewayv2_deployment.test.id
+}
+`)
+}
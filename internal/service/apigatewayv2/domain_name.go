@@ -99,7 +99,6 @@ func ResourceDomainName() *schema.Resource {
 						"truststore_uri": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"truststore_version": {
 							Type:     schema.TypeString,
@@ -213,9 +212,8 @@ func resourceDomainNameUpdate(d *schema.ResourceData, meta interface{}) error {
 					TruststoreUri: aws.String(""),
 				}
 			} else {
-				input.MutualTlsAuthentication = &apigatewayv2.MutualTlsAuthenticationInput{
-					TruststoreVersion: aws.String(mutTLSAuth[0].(map[string]interface{})["truststore_version"].(string)),
-				}
+				// The truststore URI and version are both updated in place.
+				input.MutualTlsAuthentication = expandMutualTLSAuthentication(mutTLSAuth)
 			}
 		}
 
@@ -233,7 +231,7 @@ func resourceDomainNameUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n, meta.(*conns.AWSClient).IgnoreTagsConfig); err != nil {
 			return fmt.Errorf("error updating API Gateway v2 domain name (%s) tags: %w", d.Id(), err)
 		}
 	}
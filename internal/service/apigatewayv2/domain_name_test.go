@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -292,6 +293,56 @@ func TestAccAPIGatewayV2DomainName_MutualTLSAuthentication_basic(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2DomainName_MutualTLSAuthentication_updateVersion(t *testing.T) {
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := fmt.Sprintf("%s.%s", acctest.RandomSubdomain(), rootDomain)
+
+	var v1, v2, v3 apigatewayv2.GetDomainNameOutput
+	resourceName := "aws_apigatewayv2_domain_name.test"
+	s3ObjectResourceName := "aws_s3_object.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key1 := rName
+	key2 := fmt.Sprintf("%s-updated", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainNameDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainNameMututalTLSAuthenticationTruststoreConfig(rName, rootDomain, domain, key1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainNameExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "mutual_tls_authentication.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mutual_tls_authentication.0.truststore_uri", fmt.Sprintf("s3://%s/%s", rName, key1)),
+					resource.TestCheckResourceAttrPair(resourceName, "mutual_tls_authentication.0.truststore_version", s3ObjectResourceName, "version_id"),
+				),
+			},
+			{
+				Config: testAccDomainNameMututalTLSAuthenticationTruststoreConfig(rName, rootDomain, domain, key1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainNameExists(resourceName, &v2),
+					testAccCheckDomainNameNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "mutual_tls_authentication.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mutual_tls_authentication.0.truststore_uri", fmt.Sprintf("s3://%s/%s", rName, key1)),
+					resource.TestCheckResourceAttrPair(resourceName, "mutual_tls_authentication.0.truststore_version", s3ObjectResourceName, "version_id"),
+				),
+			},
+			{
+				Config: testAccDomainNameMututalTLSAuthenticationTruststoreConfig(rName, rootDomain, domain, key2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainNameExists(resourceName, &v3),
+					testAccCheckDomainNameNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "mutual_tls_authentication.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mutual_tls_authentication.0.truststore_uri", fmt.Sprintf("s3://%s/%s", rName, key2)),
+					resource.TestCheckResourceAttrPair(resourceName, "mutual_tls_authentication.0.truststore_version", s3ObjectResourceName, "version_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayV2DomainName_MutualTLSAuthentication_noVersion(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
 	domain := fmt.Sprintf("%s.%s", acctest.RandomSubdomain(), rootDomain)
@@ -400,6 +451,20 @@ func testAccCheckDomainNameDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckDomainNameNotRecreated(i, j *apigatewayv2.GetDomainNameOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(i.DomainNameConfigurations) == 0 || len(j.DomainNameConfigurations) == 0 {
+			return fmt.Errorf("API Gateway v2 domain name has no domain name configuration")
+		}
+
+		if aws.StringValue(i.DomainNameConfigurations[0].ApiGatewayDomainName) != aws.StringValue(j.DomainNameConfigurations[0].ApiGatewayDomainName) {
+			return fmt.Errorf("API Gateway v2 domain name recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDomainNameExists(n string, v *apigatewayv2.GetDomainNameOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -597,6 +662,46 @@ resource "aws_apigatewayv2_domain_name" "test" {
 `, rName))
 }
 
+func testAccDomainNameMututalTLSAuthenticationTruststoreConfig(rName, rootDomain, domain, key string, truststore int) string {
+	return acctest.ConfigCompose(
+		testAccDomainNamePublicCertConfig(rootDomain, domain),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket_versioning.test.bucket
+  key    = %[2]q
+  source = "test-fixtures/apigateway-domain-name-truststore-%[3]d.pem"
+}
+
+resource "aws_apigatewayv2_domain_name" "test" {
+  domain_name = aws_acm_certificate.test.domain_name
+
+  domain_name_configuration {
+    certificate_arn = aws_acm_certificate_validation.test.certificate_arn
+    endpoint_type   = "REGIONAL"
+    security_policy = "TLS_1_2"
+  }
+
+  mutual_tls_authentication {
+    truststore_uri     = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+    truststore_version = aws_s3_object.test.version_id
+  }
+}
+`, rName, key, truststore))
+}
+
 func testAccDomainNameMututalTLSAuthenticationMissingConfig(rootDomain, domain string) string {
 	return acctest.ConfigCompose(
 		testAccDomainNamePublicCertConfig(rootDomain, domain),
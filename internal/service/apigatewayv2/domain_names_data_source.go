@@ -0,0 +1,127 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceDomainNames() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDomainNamesRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_mapping_selection_expression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_name_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"endpoint_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"hosted_zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ownership_verification_certificate_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"security_policy": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"target_domain_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"name_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceDomainNamesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	domainNames, err := FindDomainNames(conn, &apigatewayv2.GetDomainNamesInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 domain names: %w", err)
+	}
+
+	sort.Slice(domainNames, func(i, j int) bool {
+		return aws.StringValue(domainNames[i].DomainName) < aws.StringValue(domainNames[j].DomainName)
+	})
+
+	var ids []*string
+	var tfList []interface{}
+
+	for _, domainName := range domainNames {
+		if v, ok := d.GetOk("name_suffix"); ok && !strings.HasSuffix(aws.StringValue(domainName.DomainName), v.(string)) {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"api_mapping_selection_expression": aws.StringValue(domainName.ApiMappingSelectionExpression),
+			"domain_name":                      aws.StringValue(domainName.DomainName),
+		}
+
+		if v := domainName.DomainNameConfigurations; len(v) > 0 {
+			tfMap["domain_name_configuration"] = flattenDomainNameConfiguration(v[0])
+		}
+
+		ids = append(ids, domainName.DomainName)
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("domain_names", tfList); err != nil {
+		return fmt.Errorf("error setting domain_names: %w", err)
+	}
+
+	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	return nil
+}
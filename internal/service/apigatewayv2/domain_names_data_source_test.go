@@ -0,0 +1,53 @@
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2DomainNamesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_domain_names.test"
+	resourceName := "aws_apigatewayv2_domain_name.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	domainName := fmt.Sprintf("%s.example.com", rName)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, domainName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainNameDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainNamesDataSourceConfig(rName, certificate, key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "domain_names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_names.0.api_mapping_selection_expression", resourceName, "api_mapping_selection_expression"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_names.0.domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "domain_names.0.domain_name_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_names.0.domain_name_configuration.0.certificate_arn", resourceName, "domain_name_configuration.0.certificate_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_names.0.domain_name_configuration.0.endpoint_type", resourceName, "domain_name_configuration.0.endpoint_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_names.0.domain_name_configuration.0.hosted_zone_id", resourceName, "domain_name_configuration.0.hosted_zone_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_names.0.domain_name_configuration.0.security_policy", resourceName, "domain_name_configuration.0.security_policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_names.0.domain_name_configuration.0.target_domain_name", resourceName, "domain_name_configuration.0.target_domain_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainNamesDataSourceConfig(rName, certificate, key string) string {
+	return acctest.ConfigCompose(testAccDomainNameConfig_basic(rName, certificate, key, 1, 0), `
+data "aws_apigatewayv2_domain_names" "test" {
+  name_suffix = aws_apigatewayv2_domain_name.test.domain_name
+}
+`)
+}
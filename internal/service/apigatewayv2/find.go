@@ -72,6 +72,107 @@ func FindAPIs(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetApisInput)
 	return apis, nil
 }
 
+// FindAPIMappings returns the API mappings corresponding to the specified input.
+// Returns an empty slice if no API mappings are found.
+func FindAPIMappings(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetApiMappingsInput) ([]*apigatewayv2.ApiMapping, error) {
+	var apiMappings []*apigatewayv2.ApiMapping
+
+	err := getAPIMappingsPages(conn, input, func(page *apigatewayv2.GetApiMappingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			apiMappings = append(apiMappings, item)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return apiMappings, nil
+}
+
+// FindAuthorizers returns the authorizers corresponding to the specified input.
+// Returns an empty slice if no authorizers are found.
+func FindAuthorizers(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetAuthorizersInput) ([]*apigatewayv2.Authorizer, error) {
+	var authorizers []*apigatewayv2.Authorizer
+
+	err := getAuthorizersPages(conn, input, func(page *apigatewayv2.GetAuthorizersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			authorizers = append(authorizers, item)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return authorizers, nil
+}
+
+// FindDeploymentByID returns the deployment corresponding to the specified API and deployment IDs.
+// Returns NotFoundError if no deployment is found.
+func FindDeploymentByID(conn *apigatewayv2.ApiGatewayV2, apiID, deploymentID string) (*apigatewayv2.GetDeploymentOutput, error) {
+	input := &apigatewayv2.GetDeploymentInput{
+		ApiId:        aws.String(apiID),
+		DeploymentId: aws.String(deploymentID),
+	}
+
+	return FindDeployment(conn, input)
+}
+
+// FindDeployment returns the deployment corresponding to the specified input.
+// Returns NotFoundError if no deployment is found.
+func FindDeployment(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetDeploymentInput) (*apigatewayv2.GetDeploymentOutput, error) {
+	output, err := conn.GetDeployment(input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle any empty result.
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindDomainNameByName(conn *apigatewayv2.ApiGatewayV2, name string) (*apigatewayv2.GetDomainNameOutput, error) {
 	input := &apigatewayv2.GetDomainNameInput{
 		DomainName: aws.String(name),
@@ -104,3 +205,274 @@ func FindDomainName(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetDoma
 
 	return output, nil
 }
+
+// FindDomainNames returns the domain names corresponding to the specified input.
+// Returns an empty slice if no domain names are found.
+func FindDomainNames(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetDomainNamesInput) ([]*apigatewayv2.DomainName, error) {
+	var domainNames []*apigatewayv2.DomainName
+
+	err := getDomainNamesPages(conn, input, func(page *apigatewayv2.GetDomainNamesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			domainNames = append(domainNames, item)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return domainNames, nil
+}
+
+// FindIntegrationResponses returns the integration responses corresponding to the specified input.
+// Returns an empty slice if no integration responses are found.
+func FindIntegrationResponses(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetIntegrationResponsesInput) ([]*apigatewayv2.IntegrationResponse, error) {
+	var integrationResponses []*apigatewayv2.IntegrationResponse
+
+	err := getIntegrationResponsesPages(conn, input, func(page *apigatewayv2.GetIntegrationResponsesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			integrationResponses = append(integrationResponses, item)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return integrationResponses, nil
+}
+
+// FindIntegrations returns the integrations corresponding to the specified input.
+// Returns an empty slice if no integrations are found.
+func FindIntegrations(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetIntegrationsInput) ([]*apigatewayv2.Integration, error) {
+	var integrations []*apigatewayv2.Integration
+
+	err := getIntegrationsPages(conn, input, func(page *apigatewayv2.GetIntegrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			integrations = append(integrations, item)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return integrations, nil
+}
+
+// FindModels returns the models corresponding to the specified input.
+// Returns an empty slice if no models are found.
+func FindModels(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetModelsInput) ([]*apigatewayv2.Model, error) {
+	var models []*apigatewayv2.Model
+
+	err := getModelsPages(conn, input, func(page *apigatewayv2.GetModelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			models = append(models, item)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+// FindRoutes returns the routes corresponding to the specified input.
+// Returns an empty slice if no routes are found.
+func FindRoutes(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRoutesInput) ([]*apigatewayv2.Route, error) {
+	var routes []*apigatewayv2.Route
+
+	err := getRoutesPages(conn, input, func(page *apigatewayv2.GetRoutesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			routes = append(routes, item)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
+
+// FindRouteResponses returns the route responses corresponding to the specified input.
+// Returns an empty slice if no route responses are found.
+func FindRouteResponses(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRouteResponsesInput) ([]*apigatewayv2.RouteResponse, error) {
+	var routeResponses []*apigatewayv2.RouteResponse
+
+	err := getRouteResponsesPages(conn, input, func(page *apigatewayv2.GetRouteResponsesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			routeResponses = append(routeResponses, item)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return routeResponses, nil
+}
+
+// FindStageByName returns the stage corresponding to the specified API ID and stage name.
+// Returns NotFoundError if no stage is found.
+func FindStageByName(conn *apigatewayv2.ApiGatewayV2, apiID, name string) (*apigatewayv2.GetStageOutput, error) {
+	input := &apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiID),
+		StageName: aws.String(name),
+	}
+
+	return FindStage(conn, input)
+}
+
+// FindStage returns the stage corresponding to the specified input.
+// Returns NotFoundError if no stage is found.
+func FindStage(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetStageInput) (*apigatewayv2.GetStageOutput, error) {
+	output, err := conn.GetStage(input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle any empty result.
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindVPCLinkByID returns the VPC link corresponding to the specified ID.
+// Returns NotFoundError if no VPC link is found.
+func FindVPCLinkByID(conn *apigatewayv2.ApiGatewayV2, vpcLinkID string) (*apigatewayv2.GetVpcLinkOutput, error) {
+	input := &apigatewayv2.GetVpcLinkInput{
+		VpcLinkId: aws.String(vpcLinkID),
+	}
+
+	return FindVPCLink(conn, input)
+}
+
+// FindVPCLink returns the VPC link corresponding to the specified input.
+// Returns NotFoundError if no VPC link is found.
+func FindVPCLink(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetVpcLinkInput) (*apigatewayv2.GetVpcLinkOutput, error) {
+	output, err := conn.GetVpcLink(input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle any empty result.
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindVPCLinks returns the VPC links corresponding to the specified input.
+// Returns an empty slice if no VPC links are found.
+func FindVPCLinks(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetVpcLinksInput) ([]*apigatewayv2.VpcLink, error) {
+	var vpcLinks []*apigatewayv2.VpcLink
+
+	err := getVPCLinksPages(conn, input, func(page *apigatewayv2.GetVpcLinksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Items {
+			if item == nil {
+				continue
+			}
+
+			vpcLinks = append(vpcLinks, item)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return vpcLinks, nil
+}
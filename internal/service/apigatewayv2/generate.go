@@ -1,5 +1,5 @@
-//go:generate go run ../../generate/listpages/main.go -ListOps=GetApis,GetDomainNames
-//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=GetTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/listpages/main.go -ListOps=GetApiMappings,GetApis,GetAuthorizers,GetDomainNames,GetIntegrationResponses,GetIntegrations,GetModels,GetRouteResponses,GetRoutes,GetVpcLinks
+//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=GetTags -ServiceTagsMap -UpdateTags -UpdateTagsIgnoreConfig -ParentNotFoundErrCode=NotFoundException
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package apigatewayv2
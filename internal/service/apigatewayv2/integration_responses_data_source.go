@@ -0,0 +1,94 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceIntegrationResponses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIntegrationResponsesRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"integration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"integration_responses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"integration_response_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_response_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIntegrationResponsesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+	integrationID := d.Get("integration_id").(string)
+
+	integrationResponses, err := FindIntegrationResponses(conn, &apigatewayv2.GetIntegrationResponsesInput{
+		ApiId:         aws.String(apiID),
+		IntegrationId: aws.String(integrationID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 integration (%s) responses: %w", integrationID, err)
+	}
+
+	sort.Slice(integrationResponses, func(i, j int) bool {
+		return aws.StringValue(integrationResponses[i].IntegrationResponseId) < aws.StringValue(integrationResponses[j].IntegrationResponseId)
+	})
+
+	var ids []*string
+	var tfList []interface{}
+
+	for _, integrationResponse := range integrationResponses {
+		ids = append(ids, integrationResponse.IntegrationResponseId)
+		tfList = append(tfList, map[string]interface{}{
+			"integration_response_id":  aws.StringValue(integrationResponse.IntegrationResponseId),
+			"integration_response_key": aws.StringValue(integrationResponse.IntegrationResponseKey),
+		})
+	}
+
+	d.SetId(integrationID)
+
+	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("integration_responses", tfList); err != nil {
+		return fmt.Errorf("error setting integration_responses: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package apigatewayv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2IntegrationResponsesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_integration_responses.test"
+	resourceName := "aws_apigatewayv2_integration_response.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationResponsesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "integration_responses.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "integration_responses.0.integration_response_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "integration_responses.0.integration_response_key", resourceName, "integration_response_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationResponsesDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationResponseConfig_basic(rName), `
+data "aws_apigatewayv2_integration_responses" "test" {
+  api_id         = aws_apigatewayv2_integration_response.test.api_id
+  integration_id = aws_apigatewayv2_integration_response.test.integration_id
+}
+`)
+}
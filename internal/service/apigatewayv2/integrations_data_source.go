@@ -0,0 +1,108 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceIntegrations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIntegrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"integration_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(apigatewayv2.IntegrationType_Values(), false),
+			},
+			"integrations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIntegrationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+
+	integrations, err := FindIntegrations(conn, &apigatewayv2.GetIntegrationsInput{
+		ApiId: aws.String(apiID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 API (%s) integrations: %w", apiID, err)
+	}
+
+	sort.Slice(integrations, func(i, j int) bool {
+		return aws.StringValue(integrations[i].IntegrationId) < aws.StringValue(integrations[j].IntegrationId)
+	})
+
+	var ids []*string
+	var tfList []interface{}
+
+	for _, integration := range integrations {
+		if v, ok := d.GetOk("integration_type"); ok && v.(string) != aws.StringValue(integration.IntegrationType) {
+			continue
+		}
+
+		ids = append(ids, integration.IntegrationId)
+		tfList = append(tfList, map[string]interface{}{
+			"connection_type":  aws.StringValue(integration.ConnectionType),
+			"integration_id":   aws.StringValue(integration.IntegrationId),
+			"integration_type": aws.StringValue(integration.IntegrationType),
+			"integration_uri":  aws.StringValue(integration.IntegrationUri),
+		})
+	}
+
+	d.SetId(apiID)
+
+	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("integrations", tfList); err != nil {
+		return fmt.Errorf("error setting integrations: %w", err)
+	}
+
+	return nil
+}
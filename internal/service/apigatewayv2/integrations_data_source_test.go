@@ -0,0 +1,67 @@
+package apigatewayv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2IntegrationsDataSource_basic(t *testing.T) {
+	dataSource1Name := "data.aws_apigatewayv2_integrations.test1"
+	dataSource2Name := "data.aws_apigatewayv2_integrations.test2"
+	resourceName := "aws_apigatewayv2_integration.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource1Name, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSource1Name, "integrations.#", "2"),
+					resource.TestCheckResourceAttr(dataSource2Name, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSource2Name, "ids.*", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "integrations.0.connection_type", resourceName, "connection_type"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "integrations.0.integration_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "integrations.0.integration_type", resourceName, "integration_type"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "integrations.0.integration_uri", resourceName, "integration_uri"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_apiWebSocket(rName), `
+resource "aws_apigatewayv2_integration" "test1" {
+  api_id           = aws_apigatewayv2_api.test.id
+  integration_type = "MOCK"
+}
+
+resource "aws_apigatewayv2_integration" "test2" {
+  api_id           = aws_apigatewayv2_api.test.id
+  integration_type = "HTTP"
+
+  integration_method = "GET"
+  integration_uri    = "https://example.com"
+}
+
+data "aws_apigatewayv2_integrations" "test1" {
+  # Force dependency on resources.
+  api_id = element([aws_apigatewayv2_integration.test1.api_id, aws_apigatewayv2_integration.test2.api_id], 0)
+}
+
+data "aws_apigatewayv2_integrations" "test2" {
+  # Force dependency on resources.
+  api_id           = element([aws_apigatewayv2_integration.test1.api_id, aws_apigatewayv2_integration.test2.api_id], 0)
+  integration_type = aws_apigatewayv2_integration.test2.integration_type
+}
+`)
+}
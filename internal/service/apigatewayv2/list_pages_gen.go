@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=GetApis,GetDomainNames"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=GetApiMappings,GetApis,GetAuthorizers,GetDomainNames,GetIntegrationResponses,GetIntegrations,GetModels,GetRouteResponses,GetRoutes,GetVpcLinks"; DO NOT EDIT.
 
 package apigatewayv2
 
@@ -9,6 +9,27 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 )
 
+func getAPIMappingsPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetApiMappingsInput, fn func(*apigatewayv2.GetApiMappingsOutput, bool) bool) error {
+	return getAPIMappingsPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func getAPIMappingsPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetApiMappingsInput, fn func(*apigatewayv2.GetApiMappingsOutput, bool) bool) error {
+	for {
+		output, err := conn.GetApiMappingsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
 func getAPIsPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetApisInput, fn func(*apigatewayv2.GetApisOutput, bool) bool) error {
 	return getAPIsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -30,6 +51,27 @@ func getAPIsPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV
 	return nil
 }
 
+func getAuthorizersPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetAuthorizersInput, fn func(*apigatewayv2.GetAuthorizersOutput, bool) bool) error {
+	return getAuthorizersPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func getAuthorizersPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetAuthorizersInput, fn func(*apigatewayv2.GetAuthorizersOutput, bool) bool) error {
+	for {
+		output, err := conn.GetAuthorizersWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
 func getDomainNamesPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetDomainNamesInput, fn func(*apigatewayv2.GetDomainNamesOutput, bool) bool) error {
 	return getDomainNamesPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -50,3 +92,129 @@ func getDomainNamesPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiG
 	}
 	return nil
 }
+
+func getIntegrationResponsesPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetIntegrationResponsesInput, fn func(*apigatewayv2.GetIntegrationResponsesOutput, bool) bool) error {
+	return getIntegrationResponsesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func getIntegrationResponsesPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetIntegrationResponsesInput, fn func(*apigatewayv2.GetIntegrationResponsesOutput, bool) bool) error {
+	for {
+		output, err := conn.GetIntegrationResponsesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func getIntegrationsPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetIntegrationsInput, fn func(*apigatewayv2.GetIntegrationsOutput, bool) bool) error {
+	return getIntegrationsPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func getIntegrationsPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetIntegrationsInput, fn func(*apigatewayv2.GetIntegrationsOutput, bool) bool) error {
+	for {
+		output, err := conn.GetIntegrationsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func getModelsPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetModelsInput, fn func(*apigatewayv2.GetModelsOutput, bool) bool) error {
+	return getModelsPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func getModelsPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetModelsInput, fn func(*apigatewayv2.GetModelsOutput, bool) bool) error {
+	for {
+		output, err := conn.GetModelsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func getRouteResponsesPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRouteResponsesInput, fn func(*apigatewayv2.GetRouteResponsesOutput, bool) bool) error {
+	return getRouteResponsesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func getRouteResponsesPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRouteResponsesInput, fn func(*apigatewayv2.GetRouteResponsesOutput, bool) bool) error {
+	for {
+		output, err := conn.GetRouteResponsesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func getRoutesPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRoutesInput, fn func(*apigatewayv2.GetRoutesOutput, bool) bool) error {
+	return getRoutesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func getRoutesPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRoutesInput, fn func(*apigatewayv2.GetRoutesOutput, bool) bool) error {
+	for {
+		output, err := conn.GetRoutesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func getVPCLinksPages(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetVpcLinksInput, fn func(*apigatewayv2.GetVpcLinksOutput, bool) bool) error {
+	return getVPCLinksPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func getVPCLinksPagesWithContext(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetVpcLinksInput, fn func(*apigatewayv2.GetVpcLinksOutput, bool) bool) error {
+	for {
+		output, err := conn.GetVpcLinksWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceModels() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceModelsRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"models": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schema": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceModelsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+
+	models, err := FindModels(conn, &apigatewayv2.GetModelsInput{
+		ApiId: aws.String(apiID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 API (%s) models: %w", apiID, err)
+	}
+
+	sort.Slice(models, func(i, j int) bool {
+		return aws.StringValue(models[i].ModelId) < aws.StringValue(models[j].ModelId)
+	})
+
+	var ids []*string
+	var tfList []interface{}
+
+	for _, model := range models {
+		ids = append(ids, model.ModelId)
+		tfList = append(tfList, map[string]interface{}{
+			"content_type": aws.StringValue(model.ContentType),
+			"description":  aws.StringValue(model.Description),
+			"model_id":     aws.StringValue(model.ModelId),
+			"name":         aws.StringValue(model.Name),
+			"schema":       aws.StringValue(model.Schema),
+		})
+	}
+
+	d.SetId(apiID)
+
+	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("models", tfList); err != nil {
+		return fmt.Errorf("error setting models: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package apigatewayv2_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2ModelsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_models.test"
+	resourceName := "aws_apigatewayv2_model.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "")
+
+	schema := `
+{
+  "$schema": "http://json-schema.org/draft-04/schema#",
+  "title": "ExampleModel",
+  "type": "object",
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelsDataSourceConfig(rName, schema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "models.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "models.0.content_type", resourceName, "content_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "models.0.description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "models.0.model_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "models.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "models.0.schema"),
+				),
+			},
+		},
+	})
+}
+
+func testAccModelsDataSourceConfig(rName, schema string) string {
+	return acctest.ConfigCompose(testAccModelConfig_allAttributes(rName, schema), `
+data "aws_apigatewayv2_models" "test" {
+  api_id = aws_apigatewayv2_model.test.api_id
+}
+`)
+}
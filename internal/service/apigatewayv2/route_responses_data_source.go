@@ -0,0 +1,94 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceRouteResponses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRouteResponsesRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"route_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"route_responses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_response_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_response_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRouteResponsesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+	routeID := d.Get("route_id").(string)
+
+	routeResponses, err := FindRouteResponses(conn, &apigatewayv2.GetRouteResponsesInput{
+		ApiId:   aws.String(apiID),
+		RouteId: aws.String(routeID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 route (%s) responses: %w", routeID, err)
+	}
+
+	sort.Slice(routeResponses, func(i, j int) bool {
+		return aws.StringValue(routeResponses[i].RouteResponseId) < aws.StringValue(routeResponses[j].RouteResponseId)
+	})
+
+	var ids []*string
+	var tfList []interface{}
+
+	for _, routeResponse := range routeResponses {
+		ids = append(ids, routeResponse.RouteResponseId)
+		tfList = append(tfList, map[string]interface{}{
+			"route_response_id":  aws.StringValue(routeResponse.RouteResponseId),
+			"route_response_key": aws.StringValue(routeResponse.RouteResponseKey),
+		})
+	}
+
+	d.SetId(routeID)
+
+	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("route_responses", tfList); err != nil {
+		return fmt.Errorf("error setting route_responses: %w", err)
+	}
+
+	return nil
+}
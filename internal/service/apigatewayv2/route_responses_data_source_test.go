@@ -0,0 +1,44 @@
+package apigatewayv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2RouteResponsesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_route_responses.test"
+	resourceName := "aws_apigatewayv2_route_response.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRouteResponsesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_responses.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "route_responses.0.route_response_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "route_responses.0.route_response_key", resourceName, "route_response_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRouteResponsesDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccRouteResponseConfig_basicWebSocket(rName), `
+data "aws_apigatewayv2_route_responses" "test" {
+  api_id   = aws_apigatewayv2_route_response.test.api_id
+  route_id = aws_apigatewayv2_route_response.test.route_id
+}
+`)
+}
@@ -0,0 +1,101 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"route_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+
+	apiID := d.Get("api_id").(string)
+
+	routes, err := FindRoutes(conn, &apigatewayv2.GetRoutesInput{
+		ApiId: aws.String(apiID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 API (%s) routes: %w", apiID, err)
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		return aws.StringValue(routes[i].RouteId) < aws.StringValue(routes[j].RouteId)
+	})
+
+	var ids []*string
+	var tfList []interface{}
+
+	for _, route := range routes {
+		if v, ok := d.GetOk("route_key"); ok && v.(string) != aws.StringValue(route.RouteKey) {
+			continue
+		}
+
+		ids = append(ids, route.RouteId)
+		tfList = append(tfList, map[string]interface{}{
+			"route_id":  aws.StringValue(route.RouteId),
+			"route_key": aws.StringValue(route.RouteKey),
+			"target":    aws.StringValue(route.Target),
+		})
+	}
+
+	d.SetId(apiID)
+
+	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("routes", tfList); err != nil {
+		return fmt.Errorf("error setting routes: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2RoutesDataSource_basic(t *testing.T) {
+	dataSource1Name := "data.aws_apigatewayv2_routes.test1"
+	dataSource2Name := "data.aws_apigatewayv2_routes.test2"
+	resourceName := "aws_apigatewayv2_route.test1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSource1Name, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSource1Name, "routes.#", "2"),
+					resource.TestCheckResourceAttr(dataSource2Name, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSource2Name, "ids.*", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "routes.0.route_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "routes.0.route_key", resourceName, "route_key"),
+					resource.TestCheckResourceAttr(dataSource2Name, "routes.0.target", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+}
+
+resource "aws_apigatewayv2_route" "test1" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "GET /example"
+}
+
+resource "aws_apigatewayv2_route" "test2" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "POST /example"
+}
+
+data "aws_apigatewayv2_routes" "test1" {
+  # Force dependency on resources.
+  api_id = element([aws_apigatewayv2_route.test1.api_id, aws_apigatewayv2_route.test2.api_id], 0)
+}
+
+data "aws_apigatewayv2_routes" "test2" {
+  # Force dependency on resources.
+  api_id    = element([aws_apigatewayv2_route.test1.api_id, aws_apigatewayv2_route.test2.api_id], 0)
+  route_key = aws_apigatewayv2_route.test1.route_key
+}
+`, rName)
+}
@@ -0,0 +1,158 @@
+package apigatewayv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceStage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStageRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_log_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_deploy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"client_certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_route_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_trace_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"detailed_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"logging_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"throttling_burst_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"throttling_rate_limit": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stage_variables": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceStageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	apiID := d.Get("api_id").(string)
+	name := d.Get("name").(string)
+
+	stage, err := FindStageByName(conn, apiID, name)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("no API Gateway v2 stage matched; change the search criteria and try again")
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 stage (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := d.Set("access_log_settings", flattenApiGatewayV2AccessLogSettings(stage.AccessLogSettings)); err != nil {
+		return fmt.Errorf("error setting access_log_settings: %w", err)
+	}
+	stageARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "apigateway",
+		Region:    meta.(*conns.AWSClient).Region,
+		Resource:  fmt.Sprintf("/apis/%s/stages/%s", apiID, name),
+	}.String()
+	d.Set("arn", stageARN)
+	d.Set("auto_deploy", stage.AutoDeploy)
+	d.Set("client_certificate_id", stage.ClientCertificateId)
+	if err := d.Set("default_route_settings", flattenApiGatewayV2DefaultRouteSettings(stage.DefaultRouteSettings)); err != nil {
+		return fmt.Errorf("error setting default_route_settings: %w", err)
+	}
+	d.Set("deployment_id", stage.DeploymentId)
+	d.Set("description", stage.Description)
+	executionARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "execute-api",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("%s/%s", apiID, name),
+	}.String()
+	d.Set("execution_arn", executionARN)
+	d.Set("name", stage.StageName)
+	if err := d.Set("stage_variables", flex.PointersMapToStringList(stage.StageVariables)); err != nil {
+		return fmt.Errorf("error setting stage_variables: %w", err)
+	}
+	if err := d.Set("tags", KeyValueTags(stage.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package apigatewayv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2StageDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_stage.test"
+	resourceName := "aws_apigatewayv2_stage.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_log_settings.#", resourceName, "access_log_settings.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_deploy", resourceName, "auto_deploy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "default_route_settings.#", resourceName, "default_route_settings.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "default_route_settings.0.logging_level", resourceName, "default_route_settings.0.logging_level"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployment_id", resourceName, "deployment_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "execution_arn", resourceName, "execution_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage_variables.%", resourceName, "stage_variables.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStageDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccStageConfig_basicWebSocket(rName), `
+data "aws_apigatewayv2_stage" "test" {
+  api_id = aws_apigatewayv2_stage.test.api_id
+  name   = aws_apigatewayv2_stage.test.name
+}
+`)
+}
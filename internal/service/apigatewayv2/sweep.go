@@ -45,17 +45,12 @@ func sweepAPIs(region string) error {
 	input := &apigatewayv2.GetApisInput{}
 	var sweeperErrs *multierror.Error
 
-	for {
-		output, err := conn.GetApis(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping API Gateway v2 API sweep for %s: %s", region, err)
-			return nil
-		}
-		if err != nil {
-			return fmt.Errorf("error retrieving API Gateway v2 APIs: %s", err)
+	err = getAPIsPages(conn, input, func(page *apigatewayv2.GetApisOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, api := range output.Items {
+		for _, api := range page.Items {
 			log.Printf("[INFO] Deleting API Gateway v2 API: %s", aws.StringValue(api.ApiId))
 			_, err := conn.DeleteApi(&apigatewayv2.DeleteApiInput{
 				ApiId: api.ApiId,
@@ -71,10 +66,16 @@ func sweepAPIs(region string) error {
 			}
 		}
 
-		if aws.StringValue(output.NextToken) == "" {
-			break
-		}
-		input.NextToken = output.NextToken
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping API Gateway v2 API sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving API Gateway v2 APIs: %w", err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
@@ -131,17 +132,12 @@ func sweepVPCLinks(region string) error {
 	input := &apigatewayv2.GetVpcLinksInput{}
 	var sweeperErrs *multierror.Error
 
-	for {
-		output, err := conn.GetVpcLinks(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping API Gateway v2 VPC Link sweep for %s: %s", region, err)
-			return nil
-		}
-		if err != nil {
-			return fmt.Errorf("error retrieving API Gateway v2 VPC Links: %s", err)
+	err = getVPCLinksPages(conn, input, func(page *apigatewayv2.GetVpcLinksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		for _, link := range output.Items {
+		for _, link := range page.Items {
 			log.Printf("[INFO] Deleting API Gateway v2 VPC Link: %s", aws.StringValue(link.VpcLinkId))
 			_, err := conn.DeleteVpcLink(&apigatewayv2.DeleteVpcLinkInput{
 				VpcLinkId: link.VpcLinkId,
@@ -168,10 +164,16 @@ func sweepVPCLinks(region string) error {
 			}
 		}
 
-		if aws.StringValue(output.NextToken) == "" {
-			break
-		}
-		input.NextToken = output.NextToken
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping API Gateway v2 VPC Link sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving API Gateway v2 VPC Links: %w", err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
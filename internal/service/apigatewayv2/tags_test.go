@@ -0,0 +1,117 @@
+package apigatewayv2_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	tfapigatewayv2 "github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestUpdateTags_ignoreConfig(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		OldTags       map[string]string
+		NewTags       map[string]string
+		DefaultConfig *tftags.DefaultConfig
+		IgnoreConfig  *tftags.IgnoreConfig
+		WantTagged    map[string]string
+		WantUntagged  []string
+	}{
+		{
+			Name:         "no ignore config",
+			OldTags:      map[string]string{"key1": "value1", "key2": "value2"},
+			NewTags:      map[string]string{"key1": "value1updated"},
+			WantTagged:   map[string]string{"key1": "value1updated"},
+			WantUntagged: []string{"key2"},
+		},
+		{
+			Name:    "ignored key",
+			OldTags: map[string]string{"key1": "value1", "ignorekey1": "value2"},
+			NewTags: map[string]string{"key1": "value1updated", "ignorekey2": "value3"},
+			IgnoreConfig: &tftags.IgnoreConfig{
+				Keys: tftags.New([]string{"ignorekey1", "ignorekey2"}),
+			},
+			WantTagged: map[string]string{"key1": "value1updated"},
+		},
+		{
+			Name:    "ignored key prefix",
+			OldTags: map[string]string{"key1": "value1", "ignoreprefix:key2": "value2"},
+			NewTags: map[string]string{"ignoreprefix:key3": "value3"},
+			IgnoreConfig: &tftags.IgnoreConfig{
+				KeyPrefixes: tftags.New([]string{"ignoreprefix:"}),
+			},
+			WantUntagged: []string{"key1"},
+		},
+		{
+			Name:    "default tags",
+			OldTags: map[string]string{"ignorekey1": "value1"},
+			NewTags: map[string]string{"key1": "value1"},
+			DefaultConfig: &tftags.DefaultConfig{
+				Tags: tftags.New(map[string]string{"defaultkey1": "defaultvalue1", "ignorekey2": "defaultvalue2"}),
+			},
+			IgnoreConfig: &tftags.IgnoreConfig{
+				Keys: tftags.New([]string{"ignorekey1", "ignorekey2"}),
+			},
+			WantTagged: map[string]string{"defaultkey1": "defaultvalue1", "key1": "value1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := newTagsStubConn(t)
+
+			var gotTagged map[string]string
+			var gotUntagged []string
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch input := r.Params.(type) {
+				case *apigatewayv2.TagResourceInput:
+					gotTagged = aws.StringValueMap(input.Tags)
+				case *apigatewayv2.UntagResourceInput:
+					gotUntagged = aws.StringValueSlice(input.TagKeys)
+					sort.Strings(gotUntagged)
+				}
+			})
+
+			newTags := testCase.DefaultConfig.MergeTags(tftags.New(testCase.NewTags))
+
+			err := tfapigatewayv2.UpdateTags(conn, "arn:aws:apigateway:us-west-2::/apis/abcdef123", testCase.OldTags, newTags.Map(), testCase.IgnoreConfig)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(gotTagged, testCase.WantTagged) {
+				t.Errorf("tagged = %v, want %v", gotTagged, testCase.WantTagged)
+			}
+
+			if !reflect.DeepEqual(gotUntagged, testCase.WantUntagged) {
+				t.Errorf("untagged = %v, want %v", gotUntagged, testCase.WantUntagged)
+			}
+		})
+	}
+}
+
+// newTagsStubConn returns an API Gateway v2 client that sends no requests.
+// Tests push a Send handler that inspects each operation's input.
+func newTagsStubConn(t *testing.T) *apigatewayv2.ApiGatewayV2 {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := apigatewayv2.New(sess)
+	conn.Handlers.Clear()
+
+	return conn
+}
@@ -0,0 +1,120 @@
+package apigatewayv2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceVPCLink() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVPCLinkRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "vpc_link_id"},
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"vpc_link_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "vpc_link_id"},
+			},
+		},
+	}
+}
+
+func dataSourceVPCLinkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	vpcLinkID := d.Get("vpc_link_id").(string)
+
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+
+		vpcLinks, err := FindVPCLinks(conn, &apigatewayv2.GetVpcLinksInput{})
+
+		if err != nil {
+			return fmt.Errorf("error reading API Gateway v2 VPC Links: %w", err)
+		}
+
+		var ids []string
+
+		for _, vpcLink := range vpcLinks {
+			if aws.StringValue(vpcLink.Name) == name {
+				ids = append(ids, aws.StringValue(vpcLink.VpcLinkId))
+			}
+		}
+
+		if len(ids) == 0 {
+			return fmt.Errorf("no API Gateway v2 VPC Link matched; change the search criteria and try again")
+		}
+
+		if len(ids) > 1 {
+			return fmt.Errorf("%d API Gateway v2 VPC Links matched; use additional constraints to reduce matches to a single VPC Link", len(ids))
+		}
+
+		vpcLinkID = ids[0]
+	}
+
+	vpcLink, err := FindVPCLinkByID(conn, vpcLinkID)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("no API Gateway v2 VPC Link matched; change the search criteria and try again")
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 VPC Link (%s): %w", vpcLinkID, err)
+	}
+
+	d.SetId(vpcLinkID)
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "apigateway",
+		Region:    meta.(*conns.AWSClient).Region,
+		Resource:  fmt.Sprintf("/vpclinks/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("name", vpcLink.Name)
+	if err := d.Set("security_group_ids", flex.FlattenStringSet(vpcLink.SecurityGroupIds)); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %w", err)
+	}
+	if err := d.Set("subnet_ids", flex.FlattenStringSet(vpcLink.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %w", err)
+	}
+	if err := d.Set("tags", KeyValueTags(vpcLink.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+	d.Set("vpc_link_id", vpcLink.VpcLinkId)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package apigatewayv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2VPCLinkDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_vpc_link.test"
+	resourceName := "aws_apigatewayv2_vpc_link.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCLinkDataSourceConfig_id(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_group_ids.#", resourceName, "security_group_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnet_ids.#", resourceName, "subnet_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Key1", resourceName, "tags.Key1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_link_id", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccVPCLinkDataSourceConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_group_ids.#", resourceName, "security_group_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnet_ids.#", resourceName, "subnet_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_link_id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCLinkDataSourceConfig_id(rName string) string {
+	return acctest.ConfigCompose(testAccVPCLinkConfig_tags(rName), `
+data "aws_apigatewayv2_vpc_link" "test" {
+  vpc_link_id = aws_apigatewayv2_vpc_link.test.id
+}
+`)
+}
+
+func testAccVPCLinkDataSourceConfig_name(rName string) string {
+	return acctest.ConfigCompose(testAccVPCLinkConfig_tags(rName), `
+data "aws_apigatewayv2_vpc_link" "test" {
+  name = aws_apigatewayv2_vpc_link.test.name
+}
+`)
+}
@@ -0,0 +1,101 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceVPCLinks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVPCLinksRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"vpc_links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_link_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVPCLinksRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	vpcLinks, err := FindVPCLinks(conn, &apigatewayv2.GetVpcLinksInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 VPC Links: %w", err)
+	}
+
+	sort.Slice(vpcLinks, func(i, j int) bool {
+		return aws.StringValue(vpcLinks[i].VpcLinkId) < aws.StringValue(vpcLinks[j].VpcLinkId)
+	})
+
+	var ids []*string
+	var tfList []interface{}
+
+	for _, vpcLink := range vpcLinks {
+		ids = append(ids, vpcLink.VpcLinkId)
+		tfList = append(tfList, map[string]interface{}{
+			"name":               aws.StringValue(vpcLink.Name),
+			"security_group_ids": flex.FlattenStringSet(vpcLink.SecurityGroupIds),
+			"subnet_ids":         flex.FlattenStringSet(vpcLink.SubnetIds),
+			"tags":               KeyValueTags(vpcLink.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map(),
+			"vpc_link_id":        aws.StringValue(vpcLink.VpcLinkId),
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("ids", flex.FlattenStringSet(ids)); err != nil {
+		return fmt.Errorf("error setting ids: %w", err)
+	}
+
+	if err := d.Set("vpc_links", tfList); err != nil {
+		return fmt.Errorf("error setting vpc_links: %w", err)
+	}
+
+	return nil
+}
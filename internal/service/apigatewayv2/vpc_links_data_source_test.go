@@ -0,0 +1,45 @@
+package apigatewayv2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2VPCLinksDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_vpc_links.test"
+	resourceName := "aws_apigatewayv2_vpc_link.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCLinksDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "vpc_links.*", map[string]string{
+						"name":                 rName,
+						"security_group_ids.#": "1",
+						"subnet_ids.#":         "2",
+						"tags.%":               "2",
+						"tags.Key1":            "Value1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCLinksDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccVPCLinkConfig_tags(rName), `
+data "aws_apigatewayv2_vpc_links" "test" {
+  depends_on = [aws_apigatewayv2_vpc_link.test]
+}
+`)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -33,14 +34,18 @@ import (
 
 func ResourceBucket() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceBucketCreate,
-		Read:   resourceBucketRead,
-		Update: resourceBucketUpdate,
-		Delete: resourceBucketDelete,
+		Create:        resourceBucketCreate,
+		Read:          resourceBucketRead,
+		Update:        resourceBucketUpdate,
+		DeleteContext: resourceBucketDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:          schema.TypeString,
@@ -1289,48 +1294,104 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).S3Conn
+	forceDestroy := d.Get("force_destroy").(bool)
 
-	log.Printf("[DEBUG] S3 Delete Bucket: %s", d.Id())
-	_, err := conn.DeleteBucket(&s3.DeleteBucketInput{
-		Bucket: aws.String(d.Id()),
-	})
+	if forceDestroy {
+		// Use a S3 service client that can handle multiple slashes in URIs.
+		// While aws_s3_object resources cannot create these object
+		// keys, other AWS services and applications using the S3 Bucket can.
+		conn = meta.(*conns.AWSClient).S3ConnURICleaningDisabled
+	}
 
-	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-		return nil
+	// Delete everything including locked objects.
+	var objectLockEnabled bool
+	objectLockConfiguration := expandS3ObjectLockConfiguration(d.Get("object_lock_configuration").([]interface{}))
+	if objectLockConfiguration != nil {
+		objectLockEnabled = aws.StringValue(objectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled
 	}
 
-	if tfawserr.ErrCodeEquals(err, "BucketNotEmpty") {
-		if d.Get("force_destroy").(bool) {
-			// Use a S3 service client that can handle multiple slashes in URIs.
-			// While aws_s3_object resources cannot create these object
-			// keys, other AWS services and applications using the S3 Bucket can.
-			conn = meta.(*conns.AWSClient).S3ConnURICleaningDisabled
+	if err := DeleteBucket(ctx, conn, d.Id(), forceDestroy, objectLockEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// bucketForceDestroyMaxPasses is the maximum number of times a bucket is emptied
+// after DeleteBucket reports that it is not empty.
+const bucketForceDestroyMaxPasses = 5
+
+// DeleteBucket deletes an S3 bucket.
+// With forceDestroy, a BucketNotEmpty error triggers a pass that empties the bucket
+// before DeleteBucket is retried, up to bucketForceDestroyMaxPasses passes.
+func DeleteBucket(ctx context.Context, conn *s3.S3, bucket string, forceDestroy, objectLockEnabled bool) error {
+	for pass := 0; ; pass++ {
+		log.Printf("[DEBUG] S3 Delete Bucket: %s", bucket)
+		_, err := conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+			Bucket: aws.String(bucket),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return nil
+		}
+
+		if tfawserr.ErrCodeEquals(err, "BucketNotEmpty") && forceDestroy {
+			if pass == bucketForceDestroyMaxPasses {
+				return fmt.Errorf("error deleting S3 Bucket (%s): still not empty after %d force_destroy passes: %w", bucket, pass, err)
+			}
 
 			// bucket may have things delete them
 			log.Printf("[DEBUG] S3 Bucket attempting to forceDestroy %+v", err)
 
-			// Delete everything including locked objects.
-			// Don't ignore any object errors or we could recurse infinitely.
-			var objectLockEnabled bool
-			objectLockConfiguration := expandS3ObjectLockConfiguration(d.Get("object_lock_configuration").([]interface{}))
-			if objectLockConfiguration != nil {
-				objectLockEnabled = aws.StringValue(objectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled
+			if err := forceDestroyBucketPass(ctx, conn, bucket, objectLockEnabled); err != nil {
+				return fmt.Errorf("error S3 Bucket force_destroy: %w", err)
 			}
-			err = DeleteAllObjectVersions(conn, d.Id(), "", objectLockEnabled, false)
 
-			if err != nil {
-				return fmt.Errorf("error S3 Bucket force_destroy: %s", err)
-			}
+			continue
+		}
 
-			// this line recurses until all objects are deleted or an error is returned
-			return resourceBucketDelete(d, meta)
+		if err != nil {
+			return fmt.Errorf("error deleting S3 Bucket (%s): %w", bucket, err)
 		}
+
+		return nil
 	}
+}
+
+// forceDestroyBucketPass deletes all object versions and delete markers and
+// aborts all in-progress multipart uploads in the bucket once.
+func forceDestroyBucketPass(ctx context.Context, conn *s3.S3, bucket string, objectLockEnabled bool) error {
+	// Don't ignore any object errors or we could loop until the timeout.
+	n, err := DeleteAllObjectVersions(ctx, conn, bucket, "", objectLockEnabled, false)
+
+	log.Printf("[INFO] Deleted %d S3 Bucket (%s) object versions and delete markers", n, bucket)
 
 	if err != nil {
-		return fmt.Errorf("error deleting S3 Bucket (%s): %s", d.Id(), err)
+		return err
+	}
+
+	// In-progress multipart uploads are not object versions, but their parts are
+	// still stored in the bucket until the upload is completed or aborted.
+	nUploads, err := abortAllMultipartUploads(ctx, conn, bucket)
+
+	if nUploads > 0 {
+		log.Printf("[INFO] Aborted %d S3 Bucket (%s) multipart uploads", nUploads, bucket)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Nothing was left to delete, so DeleteBucket is seeing objects that the
+	// listing no longer returns. Back off rather than spinning until the timeout.
+	if n == 0 && nUploads == 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(bucketForceDestroyRetryDelay):
+		}
 	}
 
 	return nil
@@ -390,9 +390,9 @@ func resourceBucketObjectDelete(d *schema.ResourceData, meta interface{}) error
 
 	var err error
 	if _, ok := d.GetOk("version_id"); ok {
-		err = DeleteAllObjectVersions(conn, bucket, key, d.Get("force_destroy").(bool), false)
+		_, err = DeleteAllObjectVersions(context.Background(), conn, bucket, key, d.Get("force_destroy").(bool), false)
 	} else {
-		err = deleteS3ObjectVersion(conn, bucket, key, "", false)
+		err = deleteS3ObjectVersion(context.Background(), conn, bucket, key, "", false)
 	}
 
 	if err != nil {
@@ -0,0 +1,183 @@
+package s3
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceBucketObjectVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBucketObjectVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"prefix"},
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"key"},
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"is_delete_marker": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_latest": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBucketObjectVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	// The same listing that DeleteAllObjectVersions uses: a key is listed as
+	// a prefix and other keys sharing that prefix are skipped.
+	input := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if key != "" {
+		input.Prefix = aws.String(key)
+	} else if v, ok := d.GetOk("prefix"); ok {
+		input.Prefix = aws.String(v.(string))
+	}
+
+	// "input.MaxKeys" refers to the page size, whereas "maxKeys" is the
+	// total number of versions and delete markers returned.
+	maxKeys := d.Get("max_keys").(int)
+	if maxKeys <= keyRequestPageSize {
+		input.MaxKeys = aws.Int64(int64(maxKeys))
+	}
+
+	var versions []interface{}
+
+	err := conn.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, objectVersion := range page.Versions {
+			if key != "" && key != aws.StringValue(objectVersion.Key) {
+				continue
+			}
+
+			versions = append(versions, flattenObjectVersion(objectVersion))
+		}
+
+		for _, deleteMarker := range page.DeleteMarkers {
+			if key != "" && key != aws.StringValue(deleteMarker.Key) {
+				continue
+			}
+
+			versions = append(versions, flattenDeleteMarkerEntry(deleteMarker))
+		}
+
+		if len(versions) >= maxKeys {
+			versions = versions[:maxKeys]
+
+			return false
+		}
+
+		if remaining := maxKeys - len(versions); remaining <= keyRequestPageSize {
+			input.MaxKeys = aws.Int64(int64(remaining))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Bucket (%s) object versions: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("error setting versions: %w", err)
+	}
+
+	return nil
+}
+
+func flattenObjectVersion(apiObject *s3.ObjectVersion) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"is_delete_marker": false,
+		"is_latest":        aws.BoolValue(apiObject.IsLatest),
+		"key":              aws.StringValue(apiObject.Key),
+		"size":             int(aws.Int64Value(apiObject.Size)),
+		"storage_class":    aws.StringValue(apiObject.StorageClass),
+		"version_id":       aws.StringValue(apiObject.VersionId),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenDeleteMarkerEntry(apiObject *s3.DeleteMarkerEntry) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"is_delete_marker": true,
+		"is_latest":        aws.BoolValue(apiObject.IsLatest),
+		"key":              aws.StringValue(apiObject.Key),
+		"version_id":       aws.StringValue(apiObject.VersionId),
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,116 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccS3BucketObjectVersionsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_s3_bucket_object_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectVersionsDataSourceConfig_resources(rName, "initial"), // NOTE: contains no data source
+			},
+			{
+				Config: testAccBucketObjectVersionsDataSourceConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "4"),
+				),
+			},
+			{
+				Config: testAccBucketObjectVersionsDataSourceConfig_key(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.key", "test-key"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.is_latest", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.is_delete_marker", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.size", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.version_id", "aws_s3_object.test1", "version_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.0.last_modified"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1.is_latest", "false"),
+				),
+			},
+			{
+				Config: testAccBucketObjectVersionsDataSourceConfig_maxKeys(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketObjectVersionsDataSourceConfig_resources(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test1" {
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "test-key"
+  content = %[2]q
+}
+
+resource "aws_s3_object" "test2" {
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "test-key-2"
+  content = %[2]q
+}
+`, rName, content)
+}
+
+func testAccBucketObjectVersionsDataSourceConfig_basic(rName, content string) string {
+	return acctest.ConfigCompose(testAccBucketObjectVersionsDataSourceConfig_resources(rName, content), `
+data "aws_s3_bucket_object_versions" "test" {
+  bucket = aws_s3_bucket.test.id
+  prefix = "test-key"
+
+  depends_on = [aws_s3_object.test1, aws_s3_object.test2]
+}
+`)
+}
+
+func testAccBucketObjectVersionsDataSourceConfig_key(rName, content string) string {
+	return acctest.ConfigCompose(testAccBucketObjectVersionsDataSourceConfig_resources(rName, content), `
+data "aws_s3_bucket_object_versions" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = aws_s3_object.test1.key
+
+  depends_on = [aws_s3_object.test2]
+}
+`)
+}
+
+func testAccBucketObjectVersionsDataSourceConfig_maxKeys(rName, content string) string {
+	return acctest.ConfigCompose(testAccBucketObjectVersionsDataSourceConfig_resources(rName, content), `
+data "aws_s3_bucket_object_versions" "test" {
+  bucket   = aws_s3_bucket.test.id
+  key      = aws_s3_object.test1.key
+  max_keys = 1
+
+  depends_on = [aws_s3_object.test2]
+}
+`)
+}
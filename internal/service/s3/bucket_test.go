@@ -1,6 +1,7 @@
 package s3_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -10,7 +11,11 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -560,6 +565,87 @@ func TestAccS3Bucket_Basic_forceDestroy(t *testing.T) {
 	})
 }
 
+func TestAccS3Bucket_Basic_forceDestroyDeleteTimeout(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	keys := make([]string, 300)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("object-%03d.txt", i)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_forceDestroyDeleteTimeout(bucketName, "1s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					testAccCheckBucketAddObjects(resourceName, keys...),
+				),
+			},
+			{
+				Config:      testAccBucketConfig_forceDestroyDeleteTimeout(bucketName, "1s"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`context deadline exceeded`),
+			},
+			// The tag changes with the timeout, so the update stores the new
+			// timeout and the post-test destroy can finish emptying the bucket.
+			{
+				Config: testAccBucketConfig_forceDestroyDeleteTimeout(bucketName, "60m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_Basic_forceDestroyWithMultipartUpload(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_forceDestroy(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					testAccCheckBucketAddObjects(resourceName, "data.txt"),
+					testAccCheckBucketCreateMultipartUploads(resourceName, "upload.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_Basic_forceDestroyWithSpecialCharacterKeys(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_forceDestroy(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					testAccCheckBucketAddObjects(resourceName, "data with spaces.txt", "100%.txt", "a+b.txt", "unicodé.txt"),
+				),
+			},
+		},
+	})
+}
+
 // By default, the AWS Go SDK cleans up URIs by removing extra slashes
 // when the service API requests use the URI as part of making a request.
 // While the aws_s3_object resource automatically cleans the key
@@ -607,6 +693,50 @@ func TestAccS3Bucket_Basic_forceDestroyWithObjectLockEnabled(t *testing.T) {
 	})
 }
 
+func TestAccS3Bucket_Basic_forceDestroyWithDeleteMarkers(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_forceDestroyWithVersioning(bucketName, s3.BucketVersioningStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					// Deleting non-existent keys in a versioned bucket creates only delete markers.
+					testAccCheckBucketDeleteObjects(resourceName, "data.txt", "prefix/more_data.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_Basic_forceDestroyWithVersioningSuspended(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_forceDestroyWithVersioning(bucketName, s3.BucketVersioningStatusSuspended),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					// Objects in a versioning-suspended bucket have the "null" version ID.
+					testAccCheckBucketAddObjects(resourceName, "data.txt", "prefix/more_data.txt"),
+				),
+			},
+		},
+	})
+}
+
 func TestBucketName(t *testing.T) {
 	validDnsNames := []string{
 		"foobar",
@@ -669,6 +799,128 @@ func TestBucketName(t *testing.T) {
 	}
 }
 
+func TestDeleteBucket_forceDestroyRetry(t *testing.T) {
+	conn := newBucketStubConn(t)
+
+	var nDeleteBucket, nDeleteObject int
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DeleteBucket":
+			nDeleteBucket++
+			if nDeleteBucket == 1 {
+				r.Error = awserr.New("BucketNotEmpty", "The bucket you tried to delete is not empty", nil)
+			}
+		case "ListObjectVersions":
+			r.Data.(*s3.ListObjectVersionsOutput).Versions = []*s3.ObjectVersion{{
+				Key:       aws.String("key1"),
+				VersionId: aws.String("version1"),
+			}}
+		case "DeleteObject":
+			nDeleteObject++
+		}
+	})
+
+	if err := tfs3.DeleteBucket(context.Background(), conn, "test-bucket", true, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := nDeleteBucket, 2; got != want {
+		t.Errorf("DeleteBucket calls = %d, want %d", got, want)
+	}
+
+	if got, want := nDeleteObject, 1; got != want {
+		t.Errorf("DeleteObject calls = %d, want %d", got, want)
+	}
+}
+
+func TestDeleteBucket_forceDestroyMaxPasses(t *testing.T) {
+	conn := newBucketStubConn(t)
+
+	var nDeleteBucket int
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DeleteBucket":
+			nDeleteBucket++
+			r.Error = awserr.New("BucketNotEmpty", "The bucket you tried to delete is not empty", nil)
+		case "ListObjectVersions":
+			r.Data.(*s3.ListObjectVersionsOutput).Versions = []*s3.ObjectVersion{{
+				Key:       aws.String("key1"),
+				VersionId: aws.String(fmt.Sprintf("version%d", nDeleteBucket)),
+			}}
+		}
+	})
+
+	err := tfs3.DeleteBucket(context.Background(), conn, "test-bucket", true, false)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := err.Error(), "still not empty after 5 force_destroy passes"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want to contain %q", got, want)
+	}
+
+	if got, want := nDeleteBucket, 6; got != want {
+		t.Errorf("DeleteBucket calls = %d, want %d", got, want)
+	}
+}
+
+func TestDeleteBucket_forceDestroyMultipartUploadsAccessDenied(t *testing.T) {
+	conn := newBucketStubConn(t)
+
+	var ops []string
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		ops = append(ops, r.Operation.Name)
+
+		switch r.Operation.Name {
+		case "DeleteBucket":
+			if len(ops) == 1 {
+				r.Error = awserr.New("BucketNotEmpty", "The bucket you tried to delete is not empty", nil)
+			}
+		case "ListObjectVersions":
+			r.Data.(*s3.ListObjectVersionsOutput).Versions = []*s3.ObjectVersion{{
+				Key:       aws.String("key1"),
+				VersionId: aws.String("version1"),
+			}}
+		case "ListMultipartUploads":
+			r.Error = awserr.New("AccessDenied", "Access Denied", nil)
+		}
+	})
+
+	if err := tfs3.DeleteBucket(context.Background(), conn, "test-bucket", true, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{"DeleteBucket", "ListObjectVersions", "DeleteObject", "ListMultipartUploads", "DeleteBucket"}
+	if !reflect.DeepEqual(ops, want) {
+		t.Errorf("operations = %v, want %v", ops, want)
+	}
+}
+
+func TestDeleteBucket_noForceDestroy(t *testing.T) {
+	conn := newBucketStubConn(t)
+
+	var nListObjectVersions int
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DeleteBucket":
+			r.Error = awserr.New("BucketNotEmpty", "The bucket you tried to delete is not empty", nil)
+		case "ListObjectVersions":
+			nListObjectVersions++
+		}
+	})
+
+	err := tfs3.DeleteBucket(context.Background(), conn, "test-bucket", false, false)
+
+	if !tfawserr.ErrCodeEquals(err, "BucketNotEmpty") {
+		t.Errorf("error = %v, want BucketNotEmpty", err)
+	}
+
+	if nListObjectVersions != 0 {
+		t.Errorf("ListObjectVersions calls = %d, want 0", nListObjectVersions)
+	}
+}
+
 func TestBucketRegionalDomainName(t *testing.T) {
 	const bucket = "bucket-name"
 
@@ -926,6 +1178,23 @@ func testAccCheckBucketDestroyWithProvider(s *terraform.State, provider *schema.
 	return nil
 }
 
+// newBucketStubConn returns an S3 client that sends no requests.
+// Tests push a Send handler that sets each operation's output or error.
+func newBucketStubConn(t *testing.T) *s3.S3 {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := s3.New(sess)
+	conn.Handlers.Clear()
+
+	return conn
+}
+
 func testAccCheckBucketExists(n string) resource.TestCheckFunc {
 	return testAccCheckBucketExistsWithProvider(n, func() *schema.Provider { return acctest.Provider })
 }
@@ -1002,6 +1271,40 @@ func testAccCheckBucketAddObjects(n string, keys ...string) resource.TestCheckFu
 	}
 }
 
+// testAccCheckBucketCreateMultipartUploads starts a multipart upload with a
+// single part for each of the specified keys and leaves it incomplete.
+func testAccCheckBucketCreateMultipartUploads(n string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		for _, key := range keys {
+			output, err := conn.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+				Bucket: aws.String(rs.Primary.ID),
+				Key:    aws.String(key),
+			})
+
+			if err != nil {
+				return fmt.Errorf("CreateMultipartUpload error: %s", err)
+			}
+
+			_, err = conn.UploadPart(&s3.UploadPartInput{
+				Body:       strings.NewReader("part"),
+				Bucket:     aws.String(rs.Primary.ID),
+				Key:        aws.String(key),
+				PartNumber: aws.Int64(1),
+				UploadId:   output.UploadId,
+			})
+
+			if err != nil {
+				return fmt.Errorf("UploadPart error: %s", err)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckBucketAddObjectsWithLegalHold(n string, keys ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
@@ -1023,6 +1326,28 @@ func testAccCheckBucketAddObjectsWithLegalHold(n string, keys ...string) resourc
 	}
 }
 
+// testAccCheckBucketDeleteObjects deletes the specified objects without a
+// version ID, which creates delete markers in versioned buckets.
+func testAccCheckBucketDeleteObjects(n string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		for _, key := range keys {
+			_, err := conn.DeleteObject(&s3.DeleteObjectInput{
+				Bucket: aws.String(rs.Primary.ID),
+				Key:    aws.String(key),
+			})
+
+			if err != nil {
+				return fmt.Errorf("DeleteObject error: %s", err)
+			}
+		}
+
+		return nil
+	}
+}
+
 // Create an S3 bucket via a CF stack so that it has system tags.
 func testAccCheckBucketCreateViaCloudFormation(n string, stackID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1377,6 +1702,23 @@ resource "aws_s3_bucket_versioning" "test" {
 `, bucketName)
 }
 
+func testAccBucketConfig_forceDestroyDeleteTimeout(bucketName, deleteTimeout string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket        = %[1]q
+  force_destroy = true
+
+  tags = {
+    DeleteTimeout = %[2]q
+  }
+
+  timeouts {
+    delete = %[2]q
+  }
+}
+`, bucketName, deleteTimeout)
+}
+
 func testAccBucketConfig_forceDestroy(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -1391,6 +1733,22 @@ resource "aws_s3_bucket_acl" "test" {
 `, bucketName)
 }
 
+func testAccBucketConfig_forceDestroyWithVersioning(bucketName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_versioning" "bucket" {
+  bucket = aws_s3_bucket.bucket.id
+  versioning_configuration {
+    status = %[2]q
+  }
+}
+`, bucketName, status)
+}
+
 func testAccBucketConfig_forceDestroyWithObjectLockEnabled(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -1,5 +1,14 @@
 package s3
 
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3/#pkg-constants
 
@@ -11,6 +20,7 @@ const (
 	ErrCodeNoSuchCORSConfiguration                   = "NoSuchCORSConfiguration"
 	ErrCodeNoSuchLifecycleConfiguration              = "NoSuchLifecycleConfiguration"
 	ErrCodeNoSuchPublicAccessBlockConfiguration      = "NoSuchPublicAccessBlockConfiguration"
+	ErrCodeNoSuchVersion                             = "NoSuchVersion"
 	ErrCodeNoSuchWebsiteConfiguration                = "NoSuchWebsiteConfiguration"
 	ErrCodeNotImplemented                            = "NotImplemented"
 	ErrCodeObjectLockConfigurationNotFound           = "ObjectLockConfigurationNotFoundError"
@@ -24,3 +34,173 @@ const (
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/14645
 	ErrCodeXNotImplemented = "XNotImplemented"
 )
+
+// errMessageMFADeleteRequired is contained in the AccessDenied error returned when
+// permanently deleting an object version from a bucket with MFA Delete enabled.
+const errMessageMFADeleteRequired = "Mfa Authentication must be used"
+
+// deleteObjectVersionsErrorsMax is the maximum number of individual object
+// failures retained by a DeleteObjectVersionsError.
+const deleteObjectVersionsErrorsMax = 10
+
+// abortMultipartUploadsErrorsMax is the maximum number of individual upload
+// failures retained by an AbortMultipartUploadsError.
+const abortMultipartUploadsErrorsMax = 10
+
+// ObjectVersionError is the failure to delete a single S3 object version or delete marker.
+type ObjectVersionError struct {
+	Key          string
+	VersionID    string
+	StorageClass string
+	// RetainUntilDate is set when the object version is under Object Lock
+	// COMPLIANCE mode retention, which cannot be bypassed.
+	RetainUntilDate *time.Time
+	Err             error
+}
+
+func (e *ObjectVersionError) Error() string {
+	if e.RetainUntilDate != nil {
+		return fmt.Sprintf("Object (%s) Version (%s) under COMPLIANCE mode retention until %s: %s", e.Key, e.VersionID, e.RetainUntilDate.Format(time.RFC3339), e.Err)
+	}
+
+	if e.archived() {
+		return fmt.Sprintf("Object (%s) Version (%s) in %s storage class: %s", e.Key, e.VersionID, e.StorageClass, e.Err)
+	}
+
+	return fmt.Sprintf("Object (%s) Version (%s): %s", e.Key, e.VersionID, e.Err)
+}
+
+func (e *ObjectVersionError) Unwrap() error {
+	return e.Err
+}
+
+// archived returns whether the object version is in an archival storage class,
+// where a restore may be in progress.
+func (e *ObjectVersionError) archived() bool {
+	switch e.StorageClass {
+	case s3.ObjectStorageClassDeepArchive, s3.ObjectStorageClassGlacier:
+		return true
+	}
+
+	return false
+}
+
+// DeleteObjectVersionsError is returned when at least one object version or
+// delete marker in an S3 bucket could not be deleted.
+// Every failure is counted, but only the first few are retained in Errors.
+type DeleteObjectVersionsError struct {
+	Bucket  string
+	Deleted int
+	Failed  int
+	Errors  []*ObjectVersionError
+
+	// earliestRetainUntilDate is the earliest COMPLIANCE mode retention expiry
+	// among all failures, including those not retained in Errors.
+	earliestRetainUntilDate *time.Time
+	// mfaDeleteRequired is set when any failure, including those not retained
+	// in Errors, was caused by MFA Delete.
+	mfaDeleteRequired bool
+}
+
+func (e *DeleteObjectVersionsError) add(key, versionID, storageClass string, err error) {
+	e.addWithRetention(key, versionID, storageClass, nil, err)
+}
+
+// addWithRetention records a failure for an object version that may be under
+// Object Lock COMPLIANCE mode retention until retainUntilDate.
+func (e *DeleteObjectVersionsError) addWithRetention(key, versionID, storageClass string, retainUntilDate *time.Time, err error) {
+	e.Failed++
+
+	if retainUntilDate != nil && (e.earliestRetainUntilDate == nil || retainUntilDate.Before(*e.earliestRetainUntilDate)) {
+		e.earliestRetainUntilDate = retainUntilDate
+	}
+
+	if tfawserr.ErrMessageContains(err, "AccessDenied", errMessageMFADeleteRequired) {
+		e.mfaDeleteRequired = true
+	}
+
+	if len(e.Errors) < deleteObjectVersionsErrorsMax {
+		e.Errors = append(e.Errors, &ObjectVersionError{
+			Key:             key,
+			VersionID:       versionID,
+			StorageClass:    storageClass,
+			RetainUntilDate: retainUntilDate,
+			Err:             err,
+		})
+	}
+}
+
+func (e *DeleteObjectVersionsError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = fmt.Sprintf("\t* %s", err)
+	}
+
+	msg := fmt.Sprintf("error deleting S3 Bucket (%s) objects: %d deleted, %d failed", e.Bucket, e.Deleted, e.Failed)
+
+	if e.Failed > len(e.Errors) {
+		msg = fmt.Sprintf("%s (showing first %d)", msg, len(e.Errors))
+	}
+
+	if e.mfaDeleteRequired {
+		msg = fmt.Sprintf("%s. MFA Delete is enabled on the bucket and Terraform cannot provide an MFA token; disable MFA Delete before using force_destroy", msg)
+	}
+
+	if e.earliestRetainUntilDate != nil {
+		msg = fmt.Sprintf("%s. Objects under Object Lock COMPLIANCE mode retention cannot be deleted, even with force_destroy, until their retention expires; the earliest expires at %s", msg, e.earliestRetainUntilDate.Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("%s:\n\n%s", msg, strings.Join(msgs, "\n"))
+}
+
+// MultipartUploadError is the failure to abort a single S3 multipart upload.
+type MultipartUploadError struct {
+	Key      string
+	UploadID string
+	Err      error
+}
+
+func (e *MultipartUploadError) Error() string {
+	return fmt.Sprintf("Object (%s) multipart upload (%s): %s", e.Key, e.UploadID, e.Err)
+}
+
+func (e *MultipartUploadError) Unwrap() error {
+	return e.Err
+}
+
+// AbortMultipartUploadsError is returned when at least one in-progress multipart
+// upload in an S3 bucket could not be aborted.
+// Every failure is counted, but only the first few are retained in Errors.
+type AbortMultipartUploadsError struct {
+	Bucket  string
+	Aborted int
+	Failed  int
+	Errors  []*MultipartUploadError
+}
+
+func (e *AbortMultipartUploadsError) add(key, uploadID string, err error) {
+	e.Failed++
+
+	if len(e.Errors) < abortMultipartUploadsErrorsMax {
+		e.Errors = append(e.Errors, &MultipartUploadError{
+			Key:      key,
+			UploadID: uploadID,
+			Err:      err,
+		})
+	}
+}
+
+func (e *AbortMultipartUploadsError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = fmt.Sprintf("\t* %s", err)
+	}
+
+	msg := fmt.Sprintf("error aborting S3 Bucket (%s) multipart uploads: %d aborted, %d failed", e.Bucket, e.Aborted, e.Failed)
+
+	if e.Failed > len(e.Errors) {
+		msg = fmt.Sprintf("%s (showing first %d)", msg, len(e.Errors))
+	}
+
+	return fmt.Sprintf("%s:\n\n%s", msg, strings.Join(msgs, "\n"))
+}
@@ -0,0 +1,174 @@
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestDeleteObjectVersionsError(t *testing.T) {
+	errAccessDenied := errors.New("AccessDenied: Access Denied")
+
+	deleteErr := &DeleteObjectVersionsError{Bucket: "test-bucket"}
+	deleteErr.Deleted = 3
+
+	for i := 0; i < deleteObjectVersionsErrorsMax+2; i++ {
+		deleteErr.add(fmt.Sprintf("key%d", i), fmt.Sprintf("version%d", i), "", errAccessDenied)
+	}
+
+	if got, want := deleteErr.Failed, deleteObjectVersionsErrorsMax+2; got != want {
+		t.Errorf("Failed = %d, want %d", got, want)
+	}
+
+	if got, want := len(deleteErr.Errors), deleteObjectVersionsErrorsMax; got != want {
+		t.Fatalf("len(Errors) = %d, want %d", got, want)
+	}
+
+	if got, want := deleteErr.Errors[0].Key, "key0"; got != want {
+		t.Errorf("Errors[0].Key = %q, want %q", got, want)
+	}
+
+	if got, want := deleteErr.Errors[0].VersionID, "version0"; got != want {
+		t.Errorf("Errors[0].VersionID = %q, want %q", got, want)
+	}
+
+	if !errors.Is(deleteErr.Errors[0], errAccessDenied) {
+		t.Errorf("Errors[0] does not wrap %q", errAccessDenied)
+	}
+
+	msg := deleteErr.Error()
+
+	for _, want := range []string{
+		"S3 Bucket (test-bucket)",
+		"3 deleted, 12 failed (showing first 10)",
+		"Object (key0) Version (version0): AccessDenied",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Error() = %q, want to contain %q", msg, want)
+		}
+	}
+
+	if strings.Contains(msg, "key10") {
+		t.Errorf("Error() = %q, want not to contain %q", msg, "key10")
+	}
+}
+
+func TestDeleteObjectVersionsError_mfaDelete(t *testing.T) {
+	deleteErr := &DeleteObjectVersionsError{Bucket: "test-bucket"}
+	deleteErr.add("key1", "version1", "", errors.New("AccessDenied: Access Denied"))
+
+	if msg := deleteErr.Error(); strings.Contains(msg, "MFA Delete") {
+		t.Errorf("Error() = %q, want not to contain %q", msg, "MFA Delete")
+	}
+
+	deleteErr.add("key2", "version2", "", awserr.New("AccessDenied", "Mfa Authentication must be used for this request", nil))
+
+	if msg, want := deleteErr.Error(), "MFA Delete is enabled on the bucket"; !strings.Contains(msg, want) {
+		t.Errorf("Error() = %q, want to contain %q", msg, want)
+	}
+}
+
+func TestDeleteObjectVersionsError_mfaDeleteNotRetained(t *testing.T) {
+	deleteErr := &DeleteObjectVersionsError{Bucket: "test-bucket"}
+
+	for i := 0; i < deleteObjectVersionsErrorsMax; i++ {
+		deleteErr.add(fmt.Sprintf("key%d", i), fmt.Sprintf("version%d", i), "", errors.New("AccessDenied: Access Denied"))
+	}
+
+	deleteErr.add("key10", "version10", "", awserr.New("AccessDenied", "Mfa Authentication must be used for this request", nil))
+
+	if got, want := len(deleteErr.Errors), deleteObjectVersionsErrorsMax; got != want {
+		t.Fatalf("len(Errors) = %d, want %d", got, want)
+	}
+
+	if msg, want := deleteErr.Error(), "MFA Delete is enabled on the bucket"; !strings.Contains(msg, want) {
+		t.Errorf("Error() = %q, want to contain %q", msg, want)
+	}
+}
+
+func TestDeleteObjectVersionsError_complianceRetention(t *testing.T) {
+	errAccessDenied := errors.New("AccessDenied: Access Denied")
+	retainUntilDate1 := time.Date(2030, time.January, 2, 0, 0, 0, 0, time.UTC)
+	retainUntilDate2 := time.Date(2029, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	deleteErr := &DeleteObjectVersionsError{Bucket: "test-bucket"}
+	deleteErr.add("key1", "version1", "", errAccessDenied)
+
+	if msg := deleteErr.Error(); strings.Contains(msg, "COMPLIANCE") {
+		t.Errorf("Error() = %q, want not to contain %q", msg, "COMPLIANCE")
+	}
+
+	deleteErr.addWithRetention("key2", "version2", "", &retainUntilDate1, errAccessDenied)
+	deleteErr.addWithRetention("key3", "version3", "", &retainUntilDate2, errAccessDenied)
+
+	if msg, want := deleteErr.Error(), "the earliest expires at 2029-06-01T00:00:00Z"; !strings.Contains(msg, want) {
+		t.Errorf("Error() = %q, want to contain %q", msg, want)
+	}
+
+	if msg, want := deleteErr.Error(), "Object (key2) Version (version2) under COMPLIANCE mode retention until 2030-01-02T00:00:00Z: AccessDenied: Access Denied"; !strings.Contains(msg, want) {
+		t.Errorf("Error() = %q, want to contain %q", msg, want)
+	}
+}
+
+func TestObjectVersionError_archived(t *testing.T) {
+	err := errors.New("InvalidObjectState: The operation is not valid for the object's storage class")
+
+	for _, tc := range []struct {
+		storageClass string
+		want         string
+	}{
+		{"", "Object (key1) Version (version1): InvalidObjectState"},
+		{"STANDARD", "Object (key1) Version (version1): InvalidObjectState"},
+		{"GLACIER", "Object (key1) Version (version1) in GLACIER storage class: InvalidObjectState"},
+		{"DEEP_ARCHIVE", "Object (key1) Version (version1) in DEEP_ARCHIVE storage class: InvalidObjectState"},
+	} {
+		objectErr := &ObjectVersionError{Key: "key1", VersionID: "version1", StorageClass: tc.storageClass, Err: err}
+
+		if msg := objectErr.Error(); !strings.HasPrefix(msg, tc.want) {
+			t.Errorf("StorageClass %q: Error() = %q, want prefix %q", tc.storageClass, msg, tc.want)
+		}
+	}
+}
+
+func TestAbortMultipartUploadsError(t *testing.T) {
+	errAccessDenied := errors.New("AccessDenied: Access Denied")
+
+	abortErr := &AbortMultipartUploadsError{Bucket: "test-bucket"}
+	abortErr.Aborted = 2
+
+	for i := 0; i < abortMultipartUploadsErrorsMax+2; i++ {
+		abortErr.add(fmt.Sprintf("key%d", i), fmt.Sprintf("upload%d", i), errAccessDenied)
+	}
+
+	if got, want := abortErr.Failed, abortMultipartUploadsErrorsMax+2; got != want {
+		t.Errorf("Failed = %d, want %d", got, want)
+	}
+
+	if got, want := len(abortErr.Errors), abortMultipartUploadsErrorsMax; got != want {
+		t.Fatalf("len(Errors) = %d, want %d", got, want)
+	}
+
+	if !errors.Is(abortErr.Errors[0], errAccessDenied) {
+		t.Errorf("Errors[0] does not wrap %q", errAccessDenied)
+	}
+
+	msg := abortErr.Error()
+
+	for _, want := range []string{
+		"S3 Bucket (test-bucket) multipart uploads",
+		"2 aborted, 12 failed (showing first 10)",
+		"Object (key0) multipart upload (upload0): AccessDenied",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Error() = %q, want to contain %q", msg, want)
+		}
+	}
+
+	if strings.Contains(msg, "key10") {
+		t.Errorf("Error() = %q, want not to contain %q", msg, "key10")
+	}
+}
@@ -387,9 +387,9 @@ func resourceObjectDelete(d *schema.ResourceData, meta interface{}) error {
 
 	var err error
 	if _, ok := d.GetOk("version_id"); ok {
-		err = DeleteAllObjectVersions(conn, bucket, key, d.Get("force_destroy").(bool), false)
+		_, err = DeleteAllObjectVersions(context.Background(), conn, bucket, key, d.Get("force_destroy").(bool), false)
 	} else {
-		err = deleteS3ObjectVersion(conn, bucket, key, "", false)
+		err = deleteS3ObjectVersion(context.Background(), conn, bucket, key, "", false)
 	}
 
 	if err != nil {
@@ -614,7 +614,14 @@ func hasS3ObjectContentChanges(d verify.ResourceDiffer) bool {
 // DeleteAllObjectVersions deletes all versions of a specified key from an S3 bucket.
 // If key is empty then all versions of all objects are deleted.
 // Set force to true to override any S3 object lock protections on object lock enabled buckets.
-func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreObjectErrors bool) error {
+// Deletion stops between objects if ctx is cancelled or its deadline passes,
+// and the returned error reports how many versions were deleted before then.
+// Object failures are returned as a *DeleteObjectVersionsError.
+// A bucket that does not exist is treated as already empty.
+// The number of object versions and delete markers deleted is always returned.
+func DeleteAllObjectVersions(ctx context.Context, conn *s3.S3, bucketName, key string, force, ignoreObjectErrors bool) (int, error) {
+	// EncodingType is deliberately not set so that listed keys are returned
+	// as-is and can be passed directly to DeleteObject.
 	input := &s3.ListObjectVersionsInput{
 		Bucket: aws.String(bucketName),
 	}
@@ -622,37 +629,53 @@ func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreO
 		input.Prefix = aws.String(key)
 	}
 
-	var lastErr error
-	err := conn.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+	deleteErr := &DeleteObjectVersionsError{Bucket: bucketName}
+	var nVersions, nDeleteMarkers int
+	var deleteMarkersListed bool
+	err := conn.ListObjectVersionsPagesWithContext(ctx, input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
+		pageStart, pageVersions := time.Now(), nVersions
+
+		for _, deleteMarker := range page.DeleteMarkers {
+			if key == "" || key == aws.StringValue(deleteMarker.Key) {
+				deleteMarkersListed = true
+				break
+			}
+		}
+
 		for _, objectVersion := range page.Versions {
+			if ctx.Err() != nil {
+				return false
+			}
+
 			objectKey := aws.StringValue(objectVersion.Key)
 			objectVersionID := aws.StringValue(objectVersion.VersionId)
+			objectStorageClass := aws.StringValue(objectVersion.StorageClass)
 
 			if key != "" && key != objectKey {
 				continue
 			}
 
-			err := deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, force)
+			err := deleteS3ObjectVersion(ctx, conn, bucketName, objectKey, objectVersionID, force)
 			if tfawserr.ErrCodeEquals(err, "AccessDenied") && force {
 				// Remove any legal hold.
-				resp, err := conn.HeadObject(&s3.HeadObjectInput{
+				resp, headErr := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
 					Bucket:    aws.String(bucketName),
 					Key:       objectVersion.Key,
 					VersionId: objectVersion.VersionId,
 				})
 
-				if err != nil {
-					log.Printf("[ERROR] Error getting S3 Bucket (%s) Object (%s) Version (%s) metadata: %s", bucketName, objectKey, objectVersionID, err)
-					lastErr = err
+				if headErr != nil {
+					log.Printf("[ERROR] Error getting S3 Bucket (%s) Object (%s) Version (%s) metadata: %s", bucketName, objectKey, objectVersionID, headErr)
+					deleteErr.add(objectKey, objectVersionID, objectStorageClass, fmt.Errorf("error getting metadata: %w", headErr))
 					continue
 				}
 
 				if aws.StringValue(resp.ObjectLockLegalHoldStatus) == s3.ObjectLockLegalHoldStatusOn {
-					_, err := conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+					_, err := conn.PutObjectLegalHoldWithContext(ctx, &s3.PutObjectLegalHoldInput{
 						Bucket:    aws.String(bucketName),
 						Key:       objectVersion.Key,
 						VersionId: objectVersion.VersionId,
@@ -663,55 +686,77 @@ func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreO
 
 					if err != nil {
 						log.Printf("[ERROR] Error putting S3 Bucket (%s) Object (%s) Version(%s) legal hold: %s", bucketName, objectKey, objectVersionID, err)
-						lastErr = err
+						deleteErr.add(objectKey, objectVersionID, objectStorageClass, fmt.Errorf("error putting legal hold: %w", err))
 						continue
 					}
 
 					// Attempt to delete again.
-					err = deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, force)
+					err = deleteS3ObjectVersion(ctx, conn, bucketName, objectKey, objectVersionID, force)
 
 					if err != nil {
-						lastErr = err
+						deleteErr.addWithRetention(objectKey, objectVersionID, objectStorageClass, complianceRetainUntilDate(resp), err)
+						continue
 					}
 
+					deleteErr.Deleted++
+					nVersions++
 					continue
 				}
 
 				// AccessDenied for another reason.
-				lastErr = fmt.Errorf("AccessDenied deleting S3 Bucket (%s) Object (%s) Version: %s", bucketName, objectKey, objectVersionID)
+				deleteErr.addWithRetention(objectKey, objectVersionID, objectStorageClass, complianceRetainUntilDate(resp), err)
 				continue
 			}
 
 			if err != nil {
-				lastErr = err
+				deleteErr.add(objectKey, objectVersionID, objectStorageClass, err)
+				continue
 			}
+
+			deleteErr.Deleted++
+			nVersions++
 		}
 
+		log.Printf("[DEBUG] Deleted %d S3 Bucket (%s) object versions in %s, %d so far", nVersions-pageVersions, bucketName, time.Since(pageStart), nVersions)
+
 		return !lastPage
 	})
 
+	// The bucket is already gone, so there are no delete markers left to remove either.
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-		err = nil
+		return deleteErr.Deleted, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return deleteErr.Deleted, fmt.Errorf("error deleting S3 Bucket (%s) objects: stopped after %d deleted: %w", bucketName, deleteErr.Deleted, err)
 	}
 
 	if err != nil {
-		return err
+		return deleteErr.Deleted, err
 	}
 
-	if lastErr != nil {
-		if !ignoreObjectErrors {
-			return fmt.Errorf("error deleting at least one object version, last error: %s", lastErr)
-		}
+	if deleteErr.Failed > 0 && !ignoreObjectErrors {
+		return deleteErr.Deleted, deleteErr
+	}
 
-		lastErr = nil
+	// Deleting a specific version never adds a delete marker, so if none were
+	// listed (e.g. the bucket was never versioned) the second pass can be skipped.
+	if !deleteMarkersListed {
+		return deleteErr.Deleted, nil
 	}
 
-	err = conn.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+	err = conn.ListObjectVersionsPagesWithContext(ctx, input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
+		pageStart, pageDeleteMarkers := time.Now(), nDeleteMarkers
+
 		for _, deleteMarker := range page.DeleteMarkers {
+			if ctx.Err() != nil {
+				return false
+			}
+
 			deleteMarkerKey := aws.StringValue(deleteMarker.Key)
 			deleteMarkerVersionID := aws.StringValue(deleteMarker.VersionId)
 
@@ -720,13 +765,19 @@ func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreO
 			}
 
 			// Delete markers have no object lock protections.
-			err := deleteS3ObjectVersion(conn, bucketName, deleteMarkerKey, deleteMarkerVersionID, false)
+			err := deleteS3ObjectVersion(ctx, conn, bucketName, deleteMarkerKey, deleteMarkerVersionID, false)
 
 			if err != nil {
-				lastErr = err
+				deleteErr.add(deleteMarkerKey, deleteMarkerVersionID, "", err)
+				continue
 			}
+
+			deleteErr.Deleted++
+			nDeleteMarkers++
 		}
 
+		log.Printf("[DEBUG] Deleted %d S3 Bucket (%s) delete markers in %s, %d so far", nDeleteMarkers-pageDeleteMarkers, bucketName, time.Since(pageStart), nDeleteMarkers)
+
 		return !lastPage
 	})
 
@@ -734,16 +785,101 @@ func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreO
 		err = nil
 	}
 
+	if err := ctx.Err(); err != nil {
+		return deleteErr.Deleted, fmt.Errorf("error deleting S3 Bucket (%s) objects: stopped after %d deleted: %w", bucketName, deleteErr.Deleted, err)
+	}
+
 	if err != nil {
-		return err
+		return deleteErr.Deleted, err
+	}
+
+	if deleteErr.Failed > 0 && !ignoreObjectErrors {
+		return deleteErr.Deleted, deleteErr
 	}
 
-	if lastErr != nil {
-		if !ignoreObjectErrors {
-			return fmt.Errorf("error deleting at least one object delete marker, last error: %s", lastErr)
+	return deleteErr.Deleted, nil
+}
+
+// abortAllMultipartUploads aborts all in-progress multipart uploads in an S3 bucket.
+// Aborting stops if ctx is cancelled or its deadline passes.
+// Uploads are skipped if listing or aborting them is denied or not implemented.
+// The number of multipart uploads aborted is always returned.
+func abortAllMultipartUploads(ctx context.Context, conn *s3.S3, bucketName string) (int, error) {
+	input := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	abortErr := &AbortMultipartUploadsError{Bucket: bucketName}
+	err := conn.ListMultipartUploadsPagesWithContext(ctx, input, func(page *s3.ListMultipartUploadsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		lastErr = nil
+		for _, upload := range page.Uploads {
+			if ctx.Err() != nil {
+				return false
+			}
+
+			_, err := conn.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(bucketName),
+				Key:      upload.Key,
+				UploadId: upload.UploadId,
+			})
+
+			if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchUpload) {
+				continue
+			}
+
+			if tfawserr.ErrCodeEquals(err, "AccessDenied", ErrCodeNotImplemented) {
+				log.Printf("[WARN] Skipping S3 Bucket (%s) multipart uploads: %s", bucketName, err)
+				return false
+			}
+
+			if err != nil {
+				abortErr.add(aws.StringValue(upload.Key), aws.StringValue(upload.UploadId), err)
+				continue
+			}
+
+			abortErr.Aborted++
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return abortErr.Aborted, nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, "AccessDenied", ErrCodeNotImplemented) {
+		log.Printf("[WARN] Skipping S3 Bucket (%s) multipart uploads: %s", bucketName, err)
+		return abortErr.Aborted, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return abortErr.Aborted, fmt.Errorf("error aborting S3 Bucket (%s) multipart uploads: stopped after %d aborted: %w", bucketName, abortErr.Aborted, err)
+	}
+
+	if err != nil {
+		return abortErr.Aborted, fmt.Errorf("error listing S3 Bucket (%s) multipart uploads: %w", bucketName, err)
+	}
+
+	if abortErr.Failed > 0 {
+		return abortErr.Aborted, abortErr
+	}
+
+	return abortErr.Aborted, nil
+}
+
+// complianceRetainUntilDate returns when an object version's Object Lock COMPLIANCE
+// mode retention expires, or nil if the version is not under unexpired COMPLIANCE
+// mode retention. Unlike GOVERNANCE mode, COMPLIANCE mode cannot be bypassed.
+func complianceRetainUntilDate(output *s3.HeadObjectOutput) *time.Time {
+	if aws.StringValue(output.ObjectLockMode) != s3.ObjectLockModeCompliance {
+		return nil
+	}
+
+	if v := output.ObjectLockRetainUntilDate; v != nil && v.After(time.Now()) {
+		return v
 	}
 
 	return nil
@@ -751,7 +887,11 @@ func DeleteAllObjectVersions(conn *s3.S3, bucketName, key string, force, ignoreO
 
 // deleteS3ObjectVersion deletes a specific object version.
 // Set force to true to override any S3 object lock protections.
-func deleteS3ObjectVersion(conn *s3.S3, b, k, v string, force bool) error {
+// Objects in buckets that were never versioned or have versioning suspended have
+// the literal "null" version ID. It is passed through, because deleting without
+// a version ID would add a delete marker to a suspended bucket rather than
+// remove the object.
+func deleteS3ObjectVersion(ctx context.Context, conn *s3.S3, b, k, v string, force bool) error {
 	input := &s3.DeleteObjectInput{
 		Bucket: aws.String(b),
 		Key:    aws.String(k),
@@ -766,13 +906,14 @@ func deleteS3ObjectVersion(conn *s3.S3, b, k, v string, force bool) error {
 	}
 
 	log.Printf("[INFO] Deleting S3 Bucket (%s) Object (%s) Version: %s", b, k, v)
-	_, err := conn.DeleteObject(input)
+	_, err := conn.DeleteObjectWithContext(ctx, input)
 
 	if err != nil {
 		log.Printf("[WARN] Error deleting S3 Bucket (%s) Object (%s) Version (%s): %s", b, k, v, err)
 	}
 
-	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchKey) {
+	// The object version may already have been deleted, e.g. by a concurrent or earlier delete.
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, s3.ErrCodeNoSuchKey, ErrCodeNoSuchVersion) {
 		return nil
 	}
 
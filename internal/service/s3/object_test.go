@@ -1,18 +1,23 @@
 package s3_test
 
 import (
+	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -25,6 +30,30 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestDeleteAllObjectVersions_contextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	conn := s3.New(session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-west-2"),
+	})))
+
+	n, err := tfs3.DeleteAllObjectVersions(ctx, conn, "test-bucket", "", false, false)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("DeleteAllObjectVersions() error = %v, want %v", err, context.Canceled)
+	}
+
+	if want := "stopped after 0 deleted"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("DeleteAllObjectVersions() error = %v, want to contain %q", err, want)
+	}
+
+	if n != 0 {
+		t.Errorf("DeleteAllObjectVersions() = %d, want 0", n)
+	}
+}
+
 func TestAccS3Object_noNameNoKey(t *testing.T) {
 	bucketError := regexp.MustCompile(`bucket must not be empty`)
 	keyError := regexp.MustCompile(`key must not be empty`)
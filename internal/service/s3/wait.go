@@ -11,6 +11,7 @@ import (
 
 const (
 	bucketCreatedTimeout                          = 2 * time.Minute
+	bucketForceDestroyRetryDelay                  = 5 * time.Second
 	bucketVersioningStableTimeout                 = 1 * time.Minute
 	lifecycleConfigurationExtraRetryDelay         = 5 * time.Second
 	lifecycleConfigurationRulesPropagationTimeout = 3 * time.Minute